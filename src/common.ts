@@ -10,6 +10,32 @@ export interface Geo {
     h: number
 }
 
+// A local civil date and time, as read from a clock
+export interface LocalTime {
+    // Gregorian year
+    y: number;
+    // Month, 1 to 12
+    m: number;
+    // Day of month
+    d: number;
+    // Hour, 0 to 23
+    h: number;
+    // Minute
+    min: number;
+    // Seconds, possibly fractional
+    s: number
+}
+
+// A lot, cast as a + b - c
+export interface LotFormula {
+    // Points: numbers from planets or lotPoints
+    a: number;
+    b: number;
+    c: number;
+    // True to trade b and c in a night chart
+    reverse: boolean
+}
+
 export type DayFn = (mmt: Moment) => number;
 export type AngleConversionFn = (measure: number) => number;
 export type LongFn = (mmt: Moment, coords: Geo, planet: number, measurePerf?: boolean) => LongitudeResult;
@@ -25,7 +51,7 @@ export interface AstroFns {
     //  the angle, as a TinyGo unit.Angle (always in radians)
     findAngleFromDeg: AngleConversionFn;
 
-    // Finds the topocentric ecliptic longitude of a planet, in degrees.
+    // Finds the topocentric ecliptic longitude of a planet, in degrees, in the zodiac set by setZodiac.
     // Receives:
     //  mmt: the interesting Moment
     //  coords: coordinates of the observer, as a Geo interface
@@ -49,6 +75,7 @@ export interface AstroFns {
     //  the ascending node, in degrees.
     // Notes:
     //  Find the opposite angle 180˚ away for the descending node.
+    //  Follows the zodiac set by setZodiac.
     findAscendingNode: (jd: number) => number;
 
     // Finds the lunar phase, expressed as the difference between solar and lunar geocentric ecliptic longitude.
@@ -77,7 +104,7 @@ export interface AstroFns {
     //	raμ: Change in right ascension, in arc seconds per year
     //	declμ: Change in declination, in arc seconds per year
     // Returns:
-    //	geocentric ecliptic longitude, in degrees
+    //	geocentric ecliptic longitude, in degrees, in the zodiac set by setZodiac
     // Notes:
     //	1. The parameters are as granular as they are because star catalogs break it down this way. As we come closer to calculating
     //	 fixed star elections, we may change to decimal degrees for both measures, depending on what we can do with the star catalog.
@@ -86,6 +113,8 @@ export interface AstroFns {
     //   big difference for the Moon, and a small difference for planets. (2 degrees for the Moon, and only 8 seconds at maximum for
     //   planets.) Seeing that we are dealing with a fraction of a degree for the planets, and the stars are much farther away, the
     //   difference is likely so small that it is neglected.
+    //	3. For the stars of the embedded catalog, use findStarIndex and findStarPosition instead of a table of your own.
+    //	 Keep this function for stars the catalog does not hold.
     findStar: (
         jd: number,
         ε: number,
@@ -104,7 +133,7 @@ export interface AstroFns {
     //  jd: a Julian day
     //  coord: a Geo interface representing the observer's geographic coordinates
     // Returns:
-    //  an array, where sunrise is provided first, then sunset
+    //  an array, where sunrise is provided first, then sunset; either is 0 if it does not happen on the day
     findSunRiseSet: (jd: number, coord: Geo) => Array<number>;
 
     // Finds obliquity and local sidereal time, in radians.
@@ -115,14 +144,16 @@ export interface AstroFns {
     //  an array, where obliquity is provided first, then local sidereal time
     findObliquityLST: (jd: number, coord: Geo) => Array<number>;
 
-    // Finds Regiomontanus houses.
+    // Finds houses.
     // Receives:
     //  lst: local sidereal time, in radians
     //  ε: obliquity, in radians
     //  coord: a Geo interface representing the observer's geographic coordinates
+    //  system: the house system, from houseSystems. Defaults to Regiomontanus.
+    //  jd: the Julian day. Required for the houses to follow the zodiac set by setZodiac; without it they stay tropical.
     // Returns:
-    //  an array of the houses in order, measured in degrees.
-    findHouses: (lst: number, ε: number, coord: Geo) => Array<number>;
+    //  an array of the houses in order, measured in degrees, or an empty array if the system cannot be cast at the latitude
+    findHouses: (lst: number, ε: number, coord: Geo, system?: number, jd?: number) => Array<number>;
 
     // Converts a Julian day to the equivalent Moment.
     // Receives:
@@ -131,11 +162,201 @@ export interface AstroFns {
     // Returns:
     //  the Moment found
     jdToMoment: (jd: number, offset: number) => Moment;
+
+    // Sets the calendar in which dates are read and written.
+    // Receives:
+    //  reform: the Julian day of the first Gregorian date, from calendarReforms
+    // Returns:
+    //  nothing
+    // Notes:
+    //  Applies to findLongitude and jdToMoment, which read and write the fields of a Moment as a date in the calendar in force:
+    //  Julian before the reform, Gregorian from it on. The default is prolepticGregorian, the calendar of Moment itself.
+    //  findJD always reads a Moment as Gregorian.
+    setCalendarReform: (reform: number) => void;
+
+    // Finds the Julian day for a local date and time in an IANA time zone.
+    // Receives:
+    //  local: the date and time on the local clock
+    //  zone: the IANA zone name, such as 'America/New_York'
+    //  policy: the reading of times skipped or repeated by a clock change, from zonePolicies. Defaults to earlier.
+    // Returns:
+    //  an array, where the Julian day in UT is provided first, then the status, from zoneStatuses;
+    //  an empty array if the zone name is longer than 64 bytes, if the zone is unknown, or if the policy is strict and the time
+    //  is skipped or repeated by a clock change
+    findZonedJD: (local: LocalTime, zone: string, policy?: number) => Array<number>;
+
+    // Sets the zodiac of the longitudes found: tropical, or sidereal with an ayanamsa.
+    // Receives:
+    //  zodiac: the zodiac, from zodiacs
+    // Returns:
+    //  nothing
+    // Notes:
+    //  Applies to findLongitude, findAscendingNode, findStar, and findHouses when given a Julian day. The default is tropical.
+    setZodiac: (zodiac: number) => void;
+
+    // Sets the user-defined ayanamsa, zodiacs.userDefined.
+    // Receives:
+    //  epoch: the Julian day at which the value holds
+    //  value: the ayanamsa at the epoch, in degrees
+    // Returns:
+    //  nothing
+    // Notes:
+    //  The ayanamsa then moves with precession.
+    setUserAyanamsa: (epoch: number, value: number) => void;
+
+    // Finds an ayanamsa, in degrees.
+    // Receives:
+    //  jd: a Julian day
+    //  zodiac: the sidereal zodiac, from zodiacs
+    // Returns:
+    //  the ayanamsa, to be subtracted from a tropical longitude
+    findAyanamsa: (jd: number, zodiac: number) => number;
+
+    // Finds the lots of Fortune, Spirit, Eros, Necessity and Marriage, in degrees.
+    // Receives:
+    //  jd: a Julian day
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array of the five lots, in the zodiac set by setZodiac, then 1 for a day chart or 0 for a night chart;
+    //  an empty array on error
+    // Notes:
+    //  By night, each lot is cast the other way round.
+    findLots: (jd: number, coord: Geo) => Array<number>;
+
+    // Finds lots cast from any formulas, in degrees.
+    // Receives:
+    //  jd: a Julian day
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  formulas: up to 32 formulas, each a LotFormula
+    // Returns:
+    //  an array of the lots, in the order of the formulas and in the zodiac set by setZodiac, then 1 for a day chart or 0 for
+    //  a night chart; an empty array for more than 32 formulas, an unknown point, or Pluto outside 1885 to 2099
+    findCustomLots: (jd: number, coord: Geo, formulas: Array<LotFormula>) => Array<number>;
+
+    // Finds a star in the catalog by name, Bayer designation or HIP number.
+    // Receives:
+    //  query: a name such as 'Regulus', a Bayer designation such as 'α Leo' or 'alpha Leo', or a HIP number such as 'HIP 49669'
+    // Returns:
+    //  the index of the star in the catalog, or -1 if none matches
+    findStarIndex: (query: string) => number;
+
+    // Lists the names of the stars in the catalog.
+    // Receives:
+    //  nothing
+    // Returns:
+    //  the names, in catalog order: a star's index is its position in this array
+    findStarNames: () => Array<string>;
+
+    // Finds the geocentric apparent place of a catalog star, in degrees.
+    // Receives:
+    //  index: the index of the star in the catalog, from findStarIndex
+    //  jd: a Julian day
+    // Returns:
+    //  an array, where longitude is provided first, in the zodiac set by setZodiac, then latitude, right ascension and declination;
+    //  an empty array for an index outside the catalog
+    findStarPosition: (index: number, jd: number) => Array<number>;
+
+    // Finds the next heliacal event of a catalog star.
+    // Receives:
+    //  event: the event, from heliacalEvents
+    //  jd: a Julian day to search from
+    //  index: the index of the star in the catalog, from findStarIndex
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  av: the arcus visionis, in degrees; estimated from the star's magnitude when left out
+    // Returns:
+    //  the Julian day of the star's rising or setting on the day of the event, or 0 if none
+    findStarHeliacal: (event: number, jd: number, index: number, coord: Geo, av?: number) => number;
+
+    // Finds the next heliacal event of a planet.
+    // Receives:
+    //  event: the event, from heliacalEvents
+    //  jd: a Julian day to search from
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  av: the arcus visionis, in degrees
+    // Returns:
+    //  the Julian day of the planet's rising or setting on the day of the event, or 0 if none
+    findPlanetHeliacal: (event: number, jd: number, planet: number, coord: Geo, av: number) => number;
+
+    // Finds the next return of a body to its natal longitude, with the houses at that moment.
+    // Receives:
+    //  natalJD: the Julian day of birth
+    //  jdStart: a Julian day to search from
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  system: the house system, from houseSystems
+    //  mode: tropical or precession-corrected, from returnModes
+    // Returns:
+    //  an array: the Julian day of the return, the longitude of the body, then the twelve cusps, in the zodiac set by setZodiac;
+    //  an empty array if there is no return within 250 years, or on any other error
+    // Notes:
+    //  Longitudes are topocentric: the natal longitude is found for the same place as the return.
+    findReturn: (natalJD: number, jdStart: number, planet: number, coord: Geo, system: number, mode: number) => Array<number>;
+
+    // Finds the topocentric ecliptic position of a planet.
+    // Receives:
+    //  jd: a Julian day
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array, where longitude is provided first, in degrees in the zodiac set by setZodiac, then latitude in degrees,
+    //  then distance in AU; an empty array for an unknown planet or Pluto outside 1885 to 2099
+    findTopocentricPosition: (jd: number, planet: number, coord: Geo) => Array<number>;
+
+    // Finds the topocentric equatorial and horizontal coordinates of a planet, in degrees.
+    // Receives:
+    //  jd: a Julian day
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array: right ascension, declination, azimuth from north, true altitude, then apparent altitude with refraction;
+    //  an empty array for an unknown planet or Pluto outside 1885 to 2099
+    findHorizontalPosition: (jd: number, planet: number, coord: Geo) => Array<number>;
+
+    // Finds the geocentric daily motion of a planet.
+    // Receives:
+    //  jd: a Julian day
+    //  planet: a number representing the planet
+    // Returns:
+    //  an array: longitude and latitude speeds in degrees per day, distance speed in AU per day, then 1 if retrograde or 0 if direct;
+    //  an empty array for an unknown planet or Pluto outside 1885 to 2099
+    findSpeed: (jd: number, planet: number) => Array<number>;
+
+    // Finds the rising, transit and setting of a planet within a day.
+    // Receives:
+    //  jd: the start of the day, as a Julian day; local midnight gives the events of the local date
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array: rising, transit and setting as Julian days, 0 for an event that does not happen within the day,
+    //  then the status: 0 normal, 1 circumpolar, 2 never rises; an empty array for an unknown planet or Pluto outside 1885 to 2099
+    findRiseTransitSet: (jd: number, planet: number, coord: Geo) => Array<number>;
+
+    // Finds dawn and dusk for an altitude of the Sun on a given day.
+    // Receives:
+    //  jd: a Julian day
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  altitude: the altitude of the Sun's center, in degrees: -6 civil, -12 nautical, -18 astronomical
+    // Returns:
+    //  an array, where dawn is provided first, then dusk, then the status: 0 normal, 1 twilight never ends, 2 no such twilight
+    findTwilight: (jd: number, coord: Geo, altitude: number) => Array<number>;
+
+    // Finds the planetary hours of a local date.
+    // Receives:
+    //  jd: a Julian day within the local date, taken in local mean time
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array: the ruler of the day, then the start, end and ruler of each of the 24 hours, as Julian days and planet numbers;
+    //  an empty array on a day the Sun does not rise or set, as in polar summer and winter
+    findPlanetaryHours: (jd: number, coord: Geo) => Array<number>;
 }
 
-type PlanetNames = 'saturn' | 'jupiter' | 'mars' | 'sun' | 'venus' | 'mercury' | 'moon' | 'earth';
+type PlanetNames = 'pluto' | 'neptune' | 'uranus' | 'saturn' | 'jupiter' | 'mars' | 'sun' | 'venus' | 'mercury' | 'moon' | 'earth';
 
 export const planets: { [key in PlanetNames]: number } = {
+    pluto: 10,
+    neptune: 9,
+    uranus: 8,
     saturn: 5,
     jupiter: 4,
     mars: 3,
@@ -146,6 +367,101 @@ export const planets: { [key in PlanetNames]: number } = {
     earth: 2
 };
 
+type LotPointNames = 'ascendant' | 'fortune' | 'spirit' | 'eros' | 'necessity' | 'marriage';
+
+// Points for lot formulas, beyond the planets. Each standard lot is a point, so one lot can be cast from another.
+export const lotPoints: { [key in LotPointNames]: number } = {
+    ascendant: 11,
+    fortune: 12,
+    spirit: 13,
+    eros: 14,
+    necessity: 15,
+    marriage: 16
+};
+
+type HouseSystemNames = 'regiomontanus' | 'placidus' | 'koch' | 'campanus' | 'porphyry' | 'equal' | 'wholeSign' |
+    'alcabitius' | 'morinus' | 'meridian';
+
+export const houseSystems: { [key in HouseSystemNames]: number } = {
+    regiomontanus: 0,
+    placidus: 1,
+    koch: 2,
+    campanus: 3,
+    porphyry: 4,
+    equal: 5,
+    wholeSign: 6,
+    alcabitius: 7,
+    morinus: 8,
+    meridian: 9
+};
+
+type ZodiacNames = 'tropical' | 'lahiri' | 'faganBradley' | 'raman' | 'krishnamurti' | 'trueChitra' | 'userDefined';
+
+export const zodiacs: { [key in ZodiacNames]: number } = {
+    tropical: -1,
+    lahiri: 0,
+    faganBradley: 1,
+    raman: 2,
+    krishnamurti: 3,
+    trueChitra: 4,
+    userDefined: 5
+};
+
+type HeliacalEventNames = 'heliacalRising' | 'heliacalSetting' | 'acronychalRising' | 'acronychalSetting' | 'cosmicRising' |
+    'cosmicSetting';
+
+export const heliacalEvents: { [key in HeliacalEventNames]: number } = {
+    heliacalRising: 0,
+    heliacalSetting: 1,
+    acronychalRising: 2,
+    acronychalSetting: 3,
+    cosmicRising: 4,
+    cosmicSetting: 5
+};
+
+type ReturnModeNames = 'tropical' | 'precessed';
+
+export const returnModes: { [key in ReturnModeNames]: number } = {
+    tropical: 0,
+    precessed: 1
+};
+
+type CalendarReformNames = 'catholic' | 'france' | 'britain' | 'sweden' | 'russia' | 'prolepticGregorian' | 'prolepticJulian';
+
+// The Julian day at 0h of the first Gregorian date
+export const calendarReforms: { [key in CalendarReformNames]: number } = {
+    // 1582 October 15 followed October 4: Italy, Spain, Portugal, Poland
+    catholic: 2299160.5,
+    // 1582 December 20 followed December 9
+    france: 2299226.5,
+    // 1752 September 14 followed September 2: Britain and its colonies
+    britain: 2361221.5,
+    // 1753 March 1 followed February 17
+    sweden: 2361389.5,
+    // 1918 February 14 followed January 31
+    russia: 2421638.5,
+    // the Gregorian calendar for every date
+    prolepticGregorian: -Number.MAX_VALUE,
+    // the Julian calendar for every date
+    prolepticJulian: Number.MAX_VALUE
+};
+
+type ZonePolicyNames = 'earlier' | 'later' | 'strict';
+
+export const zonePolicies: { [key in ZonePolicyNames]: number } = {
+    earlier: 0,
+    later: 1,
+    strict: 2
+};
+
+type ZoneStatusNames = 'valid' | 'gap' | 'fold';
+
+export const zoneStatuses: { [key in ZoneStatusNames]: number } = {
+    valid: 0,
+    gap: 1,
+    fold: 2
+};
+
 export interface LongitudeResult {
     eclon: number;
     perfMs?: number;
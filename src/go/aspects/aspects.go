@@ -0,0 +1,114 @@
+// Aspects: angular relationships between points of a chart.
+package aspects
+
+import (
+	"math"
+
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+// Aspect type constants, indexing Angles and Orbs.
+const (
+	Conjunction = iota
+	Opposition
+	Trine
+	Square
+	Sextile
+	// minor aspects
+	Quincunx
+	SemiSextile
+	SemiSquare
+	Sesquiquadrate
+	Quintile
+	BiQuintile
+	nAspects
+)
+
+// Angles holds the separation of each aspect type, in degrees.
+var Angles = [nAspects]float64{0, 180, 120, 90, 60, 150, 30, 45, 135, 72, 144}
+
+// Orbs holds the allowed orb of each aspect type, in degrees. A zero orb disables the aspect.
+type Orbs [nAspects]float64
+
+// DefaultOrbs are common orbs: wide for the major aspects, two degrees for the minor ones.
+var DefaultOrbs = Orbs{8, 8, 8, 7, 6, 3, 2, 2, 2, 2, 2}
+
+// Point is a longitude taking part in aspects.
+type Point struct {
+	Lon   unit.Angle // ecliptic longitude
+	Speed float64    // motion in longitude, in degrees per day; zero for house cusps and other fixed points
+}
+
+// Aspect is an aspect found between two points.
+type Aspect struct {
+	A, B      int     // indexes of the points
+	Type      int     // one of the aspect type constants
+	Orb       float64 // the orb allowed, in degrees
+	Deviation float64 // distance from exactness, in degrees
+	Exactness float64 // 1 when exact, falling to 0 at the edge of the orb
+	Applying  bool    // true if the deviation is shrinking
+}
+
+// Finds all aspects within orb among a set of points.
+// Receives:
+//	points: the points, as Point structs
+//	orbs: the orbs to use, or nil for DefaultOrbs
+// Returns:
+//	aspects: the aspects found, in order of the points, then of aspect type
+// Notes:
+//	Speeds can come from web.FindSpeed. A pair with no relative motion, such as two house cusps, is never applying.
+//	Where orbs overlap, as with a wide sextile and a quintile, both aspects are reported.
+func Find(points []Point, orbs *Orbs) (aspects []Aspect) {
+	if orbs == nil {
+		orbs = &DefaultOrbs
+	}
+	for a := 0; a < len(points); a++ {
+		for b := a + 1; b < len(points); b++ {
+			for t, angle := range Angles {
+				orb := orbs[t]
+				if orb <= 0 {
+					continue
+				}
+				if asp, ok := findAspect(points[a], points[b], t, angle, orb); ok {
+					asp.A, asp.B = a, b
+					aspects = append(aspects, asp)
+				}
+			}
+		}
+	}
+	return
+}
+
+// Finds whether two points form an aspect.
+// Receives:
+//	p, q: the points, as Point structs
+//	t: the aspect type
+//	angle: the separation of the aspect, in degrees
+//	orb: the allowed orb, in degrees
+// Returns:
+//	asp: the aspect, without point indexes
+//	ok: true if the points are within orb
+func findAspect(p, q Point, t int, angle, orb float64) (asp Aspect, ok bool) {
+	d := zabinski.FindSignedDiff(p.Lon, q.Lon)
+	sep := math.Abs(d)
+	dev := sep - angle
+	if math.Abs(dev) > orb {
+		return asp, false
+	}
+	// rate of change of the separation, then of the deviation
+	rate := p.Speed - q.Speed
+	if d < 0 {
+		rate = -rate
+	}
+	if dev < 0 {
+		rate = -rate
+	}
+	return Aspect{
+		Type:      t,
+		Orb:       orb,
+		Deviation: math.Abs(dev),
+		Exactness: 1 - math.Abs(dev)/orb,
+		Applying:  rate < 0,
+	}, true
+}
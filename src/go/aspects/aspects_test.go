@@ -0,0 +1,87 @@
+package aspects_test
+
+import (
+	"testing"
+
+	aspects "webeph/aspects"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+)
+
+func point(λ, speed float64) aspects.Point {
+	return aspects.Point{Lon: unit.AngleFromDeg(λ), Speed: speed}
+}
+
+func TestTrineAcrossAries(t *testing.T) {
+	// Moon at 350° moving toward a trine with Mars at 112°: separation 122°.
+	found := aspects.Find([]aspects.Point{point(350, 13), point(112, 0.5)}, nil)
+	if len(found) != 1 {
+		t.Fatalf("found %d aspects, want 1", len(found))
+	}
+	a := found[0]
+	if a.Type != aspects.Trine {
+		t.Errorf("type %d, want trine", a.Type)
+	}
+	if !testutils.CheckTolerance(a.Deviation, 2, 1e-9) {
+		t.Errorf("deviation %v, want 2", a.Deviation)
+	}
+	if !testutils.CheckTolerance(a.Exactness, 0.75, 1e-9) {
+		t.Errorf("exactness %v, want 0.75", a.Exactness)
+	}
+	if !a.Applying {
+		t.Error("expected applying")
+	}
+}
+
+func TestApplyingSeparating(t *testing.T) {
+	cases := []struct {
+		a, b     aspects.Point
+		typ      int
+		applying bool
+	}{
+		// faster body behind a slower one
+		{point(10, 1), point(13, 0.1), aspects.Conjunction, true},
+		// faster body past a slower one
+		{point(16, 1), point(13, 0.1), aspects.Conjunction, false},
+		// retrograde body backing into a conjunction
+		{point(16, -0.5), point(13, 0), aspects.Conjunction, true},
+		// opposition short of exact, separation growing
+		{point(0, 1), point(177, 0), aspects.Opposition, false},
+		// opposition short of exact, other side of the circle
+		{point(0, 1), point(183, 0), aspects.Opposition, true},
+		// square past exact, separation growing
+		{point(100, 0), point(8, -1), aspects.Square, false},
+	}
+	for i, c := range cases {
+		found := aspects.Find([]aspects.Point{c.a, c.b}, nil)
+		if len(found) != 1 || found[0].Type != c.typ {
+			t.Fatalf("case %d: found %+v", i, found)
+		}
+		if found[0].Applying != c.applying {
+			t.Errorf("case %d: applying %v, want %v", i, found[0].Applying, c.applying)
+		}
+	}
+}
+
+func TestOrbs(t *testing.T) {
+	pts := []aspects.Point{point(0, 0), point(61, 0), point(182, 0)}
+	// a sextile from 0° to 61°, an opposition from 0° to 182°, and a trine from 61° to 182°
+	found := aspects.Find(pts, nil)
+	if len(found) != 3 {
+		t.Fatalf("found %d aspects, want 3", len(found))
+	}
+	orbs := aspects.DefaultOrbs
+	orbs[aspects.Sextile] = 0
+	orbs[aspects.Opposition] = 1
+	found = aspects.Find(pts, &orbs)
+	if len(found) != 1 || found[0].Type != aspects.Trine || found[0].A != 1 || found[0].B != 2 {
+		t.Errorf("found %+v, want only the trine", found)
+	}
+}
+
+func TestFixedPoints(t *testing.T) {
+	found := aspects.Find([]aspects.Point{point(10, 0), point(100, 0)}, nil)
+	if len(found) != 1 || found[0].Applying {
+		t.Errorf("found %+v, want one square, not applying", found)
+	}
+}
@@ -0,0 +1,120 @@
+// Ayanamsa: the offset of a sidereal zodiac from the tropical one.
+//
+// A fixed ayanamsa is set by its value at an epoch and carried forward by the
+// general precession in longitude, (21.6) p. 136.  A true-star ayanamsa
+// holds a star at a fixed sidereal longitude instead.
+package ayanamsa
+
+import (
+	"errors"
+
+	base "webeph/base"
+	deltat "webeph/deltat"
+	nutation "webeph/nutation"
+	stars "webeph/stars"
+	unit "webeph/unit"
+)
+
+// Ayanamsa constants.
+const (
+	Lahiri       = iota // Indian Calendar Reform Committee, 1956: the Government of India standard
+	FaganBradley        // the Western sidereal standard of Cyril Fagan and Donald Bradley
+	Raman               // B. V. Raman
+	Krishnamurti        // K. S. Krishnamurti, for the KP system
+	TrueChitra          // Spica (Chitra) held at 0° Libra, 180° sidereal
+	UserDefined         // set with SetUserDefined
+)
+
+// definition sets a fixed ayanamsa by its value at an epoch.
+type definition struct {
+	epoch float64    // Julian day
+	value unit.Angle // ayanamsa at the epoch
+}
+
+// Definitions of the fixed ayanamsas, indexed by the ayanamsa constants. TrueChitra has none.
+var definitions = [UserDefined + 1]definition{
+	Lahiri:       {2435553.5, unit.AngleFromDeg(23.245524743)}, // 1956 March 21
+	FaganBradley: {2433282.42346, unit.AngleFromDeg(24.042044444)},
+	Raman:        {2415020., unit.AngleFromDeg(360 - 338.98556)}, // J1900.0
+	Krishnamurti: {2415020., unit.AngleFromDeg(360 - 337.636111)},
+	UserDefined:  {base.J2000, 0},
+}
+
+// The sidereal longitude of Spica under TrueChitra.
+var chitraLongitude = unit.AngleFromDeg(180)
+
+// Spica, from the star catalog.
+var spica = func() *stars.Star {
+	i, _ := stars.ByHIP(65474)
+	return &stars.Catalog[i]
+}()
+
+// Sets the user-defined ayanamsa.
+// Receives:
+//	epoch: the Julian day at which the value holds
+//	value: the ayanamsa at the epoch, as a unit.Angle
+// Returns:
+//	nothing
+// Notes:
+//	The ayanamsa then moves with precession, like the other fixed ones. The default is zero at J2000.0.
+func SetUserDefined(epoch float64, value unit.Angle) {
+	definitions[UserDefined] = definition{epoch, value}
+}
+
+// Finds an ayanamsa.
+// Receives:
+//	system: the ayanamsa, as an ayanamsa constant
+//	jd: the Julian day
+// Returns:
+//	a: the ayanamsa, as a unit.Angle
+//	err: an error for an unknown system
+// Notes:
+//	Fixed ayanamsas are mean: they leave out nutation, as do the longitudes of the web package.
+//	TrueChitra comes from the apparent place of Spica by stars.Star.Apparent, less nutation in longitude, so that it is
+//	in the frame of the planets: aberration in, nutation out.
+func Find(system int, jd float64) (a unit.Angle, err error) {
+	switch {
+	case system == TrueChitra:
+		Δψ, _ := nutation.Nutation(deltat.JDE(jd))
+		return spica.Apparent(jd).Lon - Δψ - chitraLongitude, nil
+	case system < 0 || system > UserDefined:
+		return 0, errors.New("Invalid ayanamsa.")
+	}
+	d := definitions[system]
+	return d.value + Precession(d.epoch, jd), nil
+}
+
+// Converts a tropical longitude to a sidereal one.
+// Receives:
+//	λ: the tropical longitude, as a unit.Angle
+//	system: the ayanamsa, as an ayanamsa constant
+//	jd: the Julian day
+// Returns:
+//	the sidereal longitude, from 0 to 2π
+//	err: an error for an unknown system
+// Notes:
+//	Applies alike to planets, house cusps, nodes and stars.
+func Sidereal(λ unit.Angle, system int, jd float64) (unit.Angle, error) {
+	a, err := Find(system, jd)
+	if err != nil {
+		return 0, err
+	}
+	return (λ - a).Mod1(), nil
+}
+
+// Finds the general precession in longitude between two dates.
+// Receives:
+//	jdFrom: the starting Julian day
+//	jdTo: the ending Julian day
+// Returns:
+//	the precession, negative if jdTo comes first
+func Precession(jdFrom, jdTo float64) unit.Angle {
+	// (21.5) p. 136
+	T := base.J2000Century(jdFrom)
+	t := (jdTo - jdFrom) / 36525
+	p := base.Horner(t,
+		base.Horner(T, 5029.0966, 2.22226, -0.000042),
+		1.11113-0.000042*T,
+		-0.000006) * t
+	return unit.AngleFromSec(p)
+}
@@ -0,0 +1,88 @@
+package ayanamsa_test
+
+import (
+	"testing"
+
+	ayanamsa "webeph/ayanamsa"
+	base "webeph/base"
+	deltat "webeph/deltat"
+	solar "webeph/solar"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+)
+
+func TestJ2000(t *testing.T) {
+	// Mean ayanamsas at J2000.0, to the arc minute.
+	for _, c := range []struct {
+		name   string
+		system int
+		want   float64
+	}{
+		{"Lahiri", ayanamsa.Lahiri, 23 + 51./60},
+		{"Fagan-Bradley", ayanamsa.FaganBradley, 24 + 44./60},
+		{"Raman", ayanamsa.Raman, 22 + 25./60},
+		{"Krishnamurti", ayanamsa.Krishnamurti, 23 + 46./60},
+	} {
+		got, err := ayanamsa.Find(c.system, base.J2000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !testutils.CheckTolerance(got.Deg(), c.want, testutils.StandardTolerance) {
+			t.Errorf("%s: %v, want %v", c.name, got.Deg(), c.want)
+		}
+	}
+}
+
+func TestTrueChitra(t *testing.T) {
+	// At J2000.0 Spica's mean longitude is the catalog place, λ 203.841356°, plus the annual aberration (23.2).
+	jd := base.J2000
+	chitra, err := ayanamsa.Find(ayanamsa.TrueChitra, jd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	λ := unit.AngleFromDeg(203.841356)
+	sun, _ := solar.True(base.J2000Century(deltat.JDE(jd)))
+	κ := unit.AngleFromSec(20.49552)
+	e, π := .016708634, unit.AngleFromDeg(102.93735)
+	β := unit.AngleFromDeg(-2.054)
+	aberration := (-κ.Mul((sun - λ).Cos()) + κ.Mul(e*(π-λ).Cos())).Div(β.Cos())
+	want := λ + aberration - unit.AngleFromDeg(180)
+	if !testutils.CheckTolerance(chitra.Deg(), want.Deg(), testutils.SecondTolerance/10) {
+		t.Errorf("True Chitra %v, want %v", chitra.Deg(), want.Deg())
+	}
+	// Lahiri was chosen to put Spica at 180° sidereal; the two still agree within about a minute of arc.
+	jd = 2459606.340277778
+	chitra, _ = ayanamsa.Find(ayanamsa.TrueChitra, jd)
+	lahiri, _ := ayanamsa.Find(ayanamsa.Lahiri, jd)
+	if !testutils.CheckTolerance(chitra.Deg(), lahiri.Deg(), 1.5*testutils.StandardTolerance) {
+		t.Errorf("True Chitra %v, Lahiri %v", chitra.Deg(), lahiri.Deg())
+	}
+}
+
+func TestUserDefined(t *testing.T) {
+	defer ayanamsa.SetUserDefined(base.J2000, 0)
+	ayanamsa.SetUserDefined(base.J2000, unit.AngleFromDeg(10))
+	a, _ := ayanamsa.Find(ayanamsa.UserDefined, base.J2000)
+	if !testutils.CheckTolerance(a.Deg(), 10, 1e-9) {
+		t.Errorf("at the epoch: %v, want 10", a.Deg())
+	}
+	// a century of general precession, 5029.0966″
+	a, _ = ayanamsa.Find(ayanamsa.UserDefined, base.J2000+36525)
+	if !testutils.CheckTolerance(a.Deg(), 10+(5029.0966+1.11113)/3600, testutils.SecondTolerance) {
+		t.Errorf("a century on: %v", a.Deg())
+	}
+}
+
+func TestSidereal(t *testing.T) {
+	// 10° tropical wraps back into Pisces.
+	λ, err := ayanamsa.Sidereal(unit.AngleFromDeg(10), ayanamsa.Lahiri, base.J2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !testutils.CheckTolerance(λ.Deg(), 370-23-51./60, testutils.StandardTolerance) {
+		t.Errorf("sidereal %v", λ.Deg())
+	}
+	if _, err := ayanamsa.Sidereal(0, ayanamsa.UserDefined+1, base.J2000); err == nil {
+		t.Error("an unknown ayanamsa should be an error")
+	}
+}
@@ -18,6 +18,7 @@ package coord
 import (
 	"math"
 
+	globe "webeph/globe"
 	unit "webeph/unit"
 )
 
@@ -116,6 +117,42 @@ type Horizontal struct {
 	Alt unit.Angle // Altitude (h)
 }
 
+// EqToHz computes Horizontal coordinates from equatorial coordinates.
+//
+// Argument g is the location of the observer on the Earth.  Argument st
+// is the sidereal time at Greenwich.
+//
+// Sidereal time must be consistent with the equatorial coordinates.
+// If coordinates are apparent, sidereal time must be apparent as well.
+func (hz *Horizontal) EqToHz(eq *Equatorial, g *globe.Coord, st unit.Time) *Horizontal {
+	hz.Az, hz.Alt = EqToHz(eq.RA, eq.Dec, g.Lat, g.Lon, st)
+	return hz
+}
+
+// EqToHz computes Horizontal coordinates from equatorial coordinates.
+//
+//	α: right ascension coordinate to transform
+//	δ: declination coordinate to transform
+//	φ: latitude of observer on Earth
+//	ψ: longitude of observer on Earth, measured positively westward
+//	st: sidereal time at Greenwich at time of observation.
+//
+// Sidereal time must be consistent with the equatorial coordinates.
+// If coordinates are apparent, sidereal time must be apparent as well.
+//
+// Results:
+//	A: azimuth of observed point, measured westward from the South.
+//	h: elevation, or height of observed point above horizon.
+func EqToHz(α unit.RA, δ unit.Angle, φ, ψ unit.Angle, st unit.Time) (A, h unit.Angle) {
+	H := st.Rad() - ψ.Rad() - α.Rad()
+	sH, cH := math.Sincos(H)
+	sφ, cφ := φ.Sincos()
+	sδ, cδ := δ.Sincos()
+	A = unit.Angle(math.Atan2(sH, cH*sφ-(sδ/cδ)*cφ)) // (13.5) p. 93
+	h = unit.Angle(math.Asin(sφ*sδ + cφ*cδ*cH))      // (13.6) p. 93
+	return
+}
+
 // Galactic coordinates are referenced to the plane of the Milky Way.
 type Galactic struct {
 	Lat unit.Angle // Latitude (b) in radians
@@ -5,6 +5,7 @@ import (
 
 	base "webeph/base"
 	coord "webeph/coord"
+	globe "webeph/globe"
 	sexa "webeph/sexagesimal"
 	unit "webeph/unit"
 )
@@ -62,3 +63,36 @@ func ExampleEqToEcl() {
 	// λ = 113°.21563
 	// β = +6°.684170
 }
+
+func ExampleEqToHz() {
+	// Example 13.b, p. 95.
+	A, h := coord.EqToHz(
+		unit.NewRA(23, 9, 16.641),
+		unit.NewAngle('-', 6, 43, 11.61),
+		unit.NewAngle(' ', 38, 55, 17),
+		unit.NewAngle(' ', 77, 3, 56),
+		unit.NewTime(' ', 8, 34, 56.853))
+	fmt.Printf("A = %+.3j\n", sexa.FmtAngle(A))
+	fmt.Printf("h = %+.3j\n", sexa.FmtAngle(h))
+	// Output:
+	// A = +68°.034
+	// h = +15°.125
+}
+
+func ExampleHorizontal_EqToHz() {
+	// Example 13.b, p. 95.
+	eq := &coord.Equatorial{
+		RA:  unit.NewRA(23, 9, 16.641),
+		Dec: unit.NewAngle('-', 6, 43, 11.61),
+	}
+	g := &globe.Coord{
+		Lat: unit.NewAngle(' ', 38, 55, 17),
+		Lon: unit.NewAngle(' ', 77, 3, 56),
+	}
+	hz := new(coord.Horizontal).EqToHz(eq, g, unit.NewTime(' ', 8, 34, 56.853))
+	fmt.Printf("A = %+.3j\n", sexa.FmtAngle(hz.Az))
+	fmt.Printf("h = %+.3j\n", sexa.FmtAngle(hz.Alt))
+	// Output:
+	// A = +68°.034
+	// h = +15°.125
+}
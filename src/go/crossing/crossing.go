@@ -0,0 +1,267 @@
+// Crossing: times when a body reaches a given ecliptic longitude.
+package crossing
+
+import (
+	"math"
+
+	ayanamsa "webeph/ayanamsa"
+	iterate "webeph/iterate"
+	pp "webeph/planetposition"
+	stations "webeph/stations"
+	unit "webeph/unit"
+	web "webeph/web"
+	zabinski "webeph/zabinski"
+)
+
+// Crossing is the moment a body reaches a longitude.
+type Crossing struct {
+	JD         float64    // Julian day, in UT
+	Lon        unit.Angle // the longitude reached, or for two bodies the separation reached
+	Retrograde bool       // true if reached in retrograde motion, or for two bodies while the separation shrinks
+}
+
+const (
+	// Scan step, in days. The Moon moves about 15° in a day, well short of the 90° limit below.
+	scanStep = 1.
+	// Width of the final bracket, in days: about a second.
+	tolerance = 1. / 86400
+)
+
+// Finds every time a body reaches a longitude between two dates.
+// Receives:
+//	planet: the required body, as a planetposition constant
+//	λ: the longitude, as a unit.Angle
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+//	site: the observer, or nil for a geocentric search
+// Returns:
+//	crossings: the crossings found, in order of time
+//	err: any errors encountered
+// Notes:
+//	A planet can reach the same longitude three times around a retrograde loop. All three are returned.
+func Find(planet int, λ unit.Angle, jdStart, jdEnd float64, site *web.Site) ([]Crossing, error) {
+	return find(planet, []unit.Angle{λ}, jdStart, jdEnd, site, nil)
+}
+
+// Finds every time a body reaches a longitude carried forward by precession.
+// Receives:
+//	planet: the required body, as a planetposition constant
+//	λ: the longitude at the epoch, as a unit.Angle
+//	epoch: the Julian day the longitude is given for
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+//	site: the observer, or nil for a geocentric search
+// Returns:
+//	crossings: the crossings found, in order of time, with Lon the longitude at the epoch
+//	err: any errors encountered
+// Notes:
+//	The longitude moves with the general precession, ayanamsa.Precession, so the body returns to the same place
+//	among the stars. In a sidereal zodiac, set with web.SetZodiac, Find already does this.
+func FindPrecessed(planet int, λ unit.Angle, epoch, jdStart, jdEnd float64, site *web.Site) ([]Crossing, error) {
+	return find(planet, []unit.Angle{λ}, jdStart, jdEnd, site, func(jd float64) unit.Angle {
+		return ayanamsa.Precession(epoch, jd)
+	})
+}
+
+// Finds every sign ingress of a body between two dates.
+// Receives:
+//	planet: the required body, as a planetposition constant
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+//	site: the observer, or nil for a geocentric search
+// Returns:
+//	ingresses: the ingresses found, in order of time
+//	err: any errors encountered
+// Notes:
+//	Lon is the cusp of the sign crossed. In direct motion the body enters the sign beginning at Lon;
+//	in retrograde motion it re-enters the sign ending there.
+func FindIngresses(planet int, jdStart, jdEnd float64, site *web.Site) ([]Crossing, error) {
+	cusps := make([]unit.Angle, 12)
+	for i := range cusps {
+		cusps[i] = unit.AngleFromDeg(float64(30 * i))
+	}
+	return find(planet, cusps, jdStart, jdEnd, site, nil)
+}
+
+// Finds every time two bodies reach a separation between two dates.
+// Receives:
+//	planetA, planetB: the required bodies, as planetposition constants
+//	angle: the separation, measured from planetB to planetA in the order of the signs, as a unit.Angle
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+//	site: the observer, or nil for a geocentric search
+// Returns:
+//	crossings: the crossings found, in order of time
+//	err: any errors encountered
+// Notes:
+//	Lon holds the separation reached. Retrograde is true when the separation is shrinking.
+//	The separation is directional: Moon minus Sun at 90° is the first quarter, at 270° the last quarter.
+//	Use FindAspect for both sides at once.
+func FindSeparation(planetA, planetB int, angle unit.Angle, jdStart, jdEnd float64, site *web.Site) ([]Crossing, error) {
+	return findPair(planetA, planetB, []unit.Angle{angle}, jdStart, jdEnd, site)
+}
+
+// Finds every time two bodies form an aspect between two dates.
+// Receives:
+//	planetA, planetB: the required bodies, as planetposition constants
+//	angle: the aspect, between 0° and 180°, as a unit.Angle
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+//	site: the observer, or nil for a geocentric search
+// Returns:
+//	crossings: the crossings found, in order of time
+//	err: any errors encountered
+// Notes:
+//	Searches the separation on both sides, so a square returns waxing and waning hits. Lon holds the directional
+//	separation reached, as in FindSeparation.
+func FindAspect(planetA, planetB int, angle unit.Angle, jdStart, jdEnd float64, site *web.Site) ([]Crossing, error) {
+	targets := []unit.Angle{angle.Mod1()}
+	if other := unit.Angle(-angle).Mod1(); math.Abs(zabinski.FindSignedDiff(other, targets[0])) > 1e-9 {
+		targets = append(targets, other)
+	}
+	return findPair(planetA, planetB, targets, jdStart, jdEnd, site)
+}
+
+// Finds every time a body reaches any of several longitudes.
+// Receives:
+//	planet: the required body, as a planetposition constant
+//	targets: the longitudes, as unit.Angles
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+//	site: the observer, or nil for a geocentric search
+//	shift: the motion of the targets, as a function of the Julian day, or nil for fixed targets
+// Returns:
+//	crossings: the crossings found, in order of time
+//	err: any errors encountered
+// Notes:
+//	The search is split at stations, so that the longitude moves one way only inside each piece.
+//	A shift is taken off the longitude rather than added to the targets; it must move slowly beside the body.
+func find(planet int, targets []unit.Angle, jdStart, jdEnd float64, site *web.Site, shift func(jd float64) unit.Angle) (crossings []Crossing, err error) {
+	bounds := []float64{jdStart}
+	if planet != pp.Sun && planet != pp.Moon {
+		found, err := stations.Find(planet, jdStart, jdEnd, site)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range found {
+			bounds = append(bounds, s.JD)
+		}
+	}
+	bounds = append(bounds, jdEnd)
+	longitude := func(jd float64) unit.Angle {
+		λ, _, _, e := web.FindPosition(jd, planet, site)
+		if e != nil {
+			err = e
+		}
+		if shift != nil {
+			λ -= shift(jd)
+		}
+		return λ
+	}
+	crossings = search(longitude, targets, bounds)
+	if err != nil {
+		return nil, err
+	}
+	return crossings, nil
+}
+
+// Finds every time the separation of two bodies reaches any of several angles.
+// Receives:
+//	planetA, planetB: the required bodies, as planetposition constants
+//	targets: the separations, as unit.Angles
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+//	site: the observer, or nil for a geocentric search
+// Returns:
+//	crossings: the crossings found, in order of time
+//	err: any errors encountered
+// Notes:
+//	The search is split where the relative speed changes sign, so that the separation moves one way only inside each piece.
+//	The Moon outruns every other body, so pairs with the Moon are not split.
+func findPair(planetA, planetB int, targets []unit.Angle, jdStart, jdEnd float64, site *web.Site) (crossings []Crossing, err error) {
+	separation := func(jd float64) unit.Angle {
+		λA, _, _, e := web.FindPosition(jd, planetA, site)
+		if e != nil {
+			err = e
+		}
+		λB, _, _, e := web.FindPosition(jd, planetB, site)
+		if e != nil {
+			err = e
+		}
+		return (λA - λB).Mod1()
+	}
+	bounds := []float64{jdStart}
+	if planetA != pp.Moon && planetB != pp.Moon {
+		relativeSpeed := func(jd float64) float64 {
+			vA, _, _, _, e := web.FindSpeed(jd, planetA, site)
+			if e != nil {
+				err = e
+			}
+			vB, _, _, _, e := web.FindSpeed(jd, planetB, site)
+			if e != nil {
+				err = e
+			}
+			return vA - vB
+		}
+		jd0 := jdStart
+		v0 := relativeSpeed(jd0)
+		for jd0 < jdEnd && err == nil {
+			jd1 := math.Min(jd0+scanStep, jdEnd)
+			v1 := relativeSpeed(jd1)
+			if (v0 > 0) != (v1 > 0) {
+				bounds = append(bounds, iterate.BinaryRoot(relativeSpeed, jd0, jd1, tolerance))
+			}
+			jd0, v0 = jd1, v1
+		}
+	}
+	bounds = append(bounds, jdEnd)
+	if err != nil {
+		return nil, err
+	}
+	crossings = search(separation, targets, bounds)
+	if err != nil {
+		return nil, err
+	}
+	return crossings, nil
+}
+
+// Scans pieces of time for the moments an angle reaches any of several targets.
+// Receives:
+//	angle: the angle as a function of the Julian day
+//	targets: the values sought, as unit.Angles
+//	bounds: the Julian days dividing the search into pieces where angle moves one way only
+// Returns:
+//	crossings: the crossings found, in order of time
+// Notes:
+//	Each piece is scanned for a change of sign in the difference from each target, then refined by bisection.
+func search(angle func(jd float64) unit.Angle, targets []unit.Angle, bounds []float64) (crossings []Crossing) {
+	for i := 1; i < len(bounds); i++ {
+		jd0 := bounds[i-1]
+		λ0 := angle(jd0)
+		for jd0 < bounds[i] {
+			jd1 := math.Min(jd0+scanStep, bounds[i])
+			λ1 := angle(jd1)
+			for _, target := range targets {
+				d0 := zabinski.FindSignedDiff(λ0, target)
+				d1 := zabinski.FindSignedDiff(λ1, target)
+				// A jump through ±180° is the opposite point, not the target.
+				if (d0 < 0) == (d1 < 0) || math.Abs(d0) > 90 || math.Abs(d1) > 90 {
+					continue
+				}
+				target := target
+				jd := iterate.BinaryRoot(func(jd float64) float64 {
+					return zabinski.FindSignedDiff(angle(jd), target)
+				}, jd0, jd1, tolerance)
+				crossings = append(crossings, Crossing{JD: jd, Lon: target, Retrograde: d1 < d0})
+			}
+			jd0, λ0 = jd1, λ1
+		}
+	}
+	// Targets inside one step are found in target order; restore time order.
+	for i := 1; i < len(crossings); i++ {
+		for j := i; j > 0 && crossings[j].JD < crossings[j-1].JD; j-- {
+			crossings[j], crossings[j-1] = crossings[j-1], crossings[j]
+		}
+	}
+	return crossings
+}
@@ -0,0 +1,172 @@
+package crossing_test
+
+import (
+	"math"
+	"testing"
+
+	ayanamsa "webeph/ayanamsa"
+	crossing "webeph/crossing"
+	julian "webeph/julian"
+	pp "webeph/planetposition"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+	web "webeph/web"
+	zabinski "webeph/zabinski"
+)
+
+var jan2022 = julian.CalendarGregorianToJD(2022, 1, 1)
+
+// Checks that the body is at the crossed longitude at the time found.
+func checkCrossings(t *testing.T, planet int, found []crossing.Crossing) {
+	for i, c := range found {
+		λ, _, _, err := web.FindPosition(c.JD, planet, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !testutils.CheckTolerance(zabinski.FindSignedDiff(λ, c.Lon), 0, testutils.SecondTolerance) {
+			t.Errorf("crossing %d: longitude %v, want %v", i, λ.Deg(), c.Lon.Deg())
+		}
+		if i > 0 && c.JD < found[i-1].JD {
+			t.Errorf("crossing %d is out of order", i)
+		}
+	}
+}
+
+func TestRetrogradeLoop(t *testing.T) {
+	// Mercury passes 0° Aquarius, turns retrograde at 10° Aquarius, passes back into Capricorn,
+	// turns direct at 24° Capricorn and enters Aquarius again in February 2022.
+	found, err := crossing.Find(pp.Mercury, unit.AngleFromDeg(300), jan2022, jan2022+60, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 3 {
+		t.Fatalf("found %d crossings, want 3", len(found))
+	}
+	for i, retrograde := range []bool{false, true, false} {
+		if found[i].Retrograde != retrograde {
+			t.Errorf("crossing %d: retrograde %v, want %v", i, found[i].Retrograde, retrograde)
+		}
+	}
+	checkCrossings(t, pp.Mercury, found)
+}
+
+func TestMoonIngresses(t *testing.T) {
+	// The Moon enters a new sign every two and a half days or so.
+	found, err := crossing.FindIngresses(pp.Moon, jan2022, jan2022+30, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) < 12 || len(found) > 14 {
+		t.Fatalf("found %d ingresses in 30 days", len(found))
+	}
+	for i, c := range found {
+		if c.Retrograde {
+			t.Errorf("ingress %d is retrograde", i)
+		}
+		if i > 0 && math.Abs(zabinski.FindSignedDiff(c.Lon, found[i-1].Lon)-30) > 1e-9 {
+			t.Errorf("ingress %d skips a sign", i)
+		}
+	}
+	checkCrossings(t, pp.Moon, found)
+}
+
+func TestTopocentric(t *testing.T) {
+	site := &web.Site{Lat: unit.AngleFromDeg(51.5), Lon: unit.AngleFromDeg(-0.1)}
+	found, err := crossing.FindIngresses(pp.Moon, jan2022, jan2022+5, site)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, c := range found {
+		λ, _, _, err := web.FindPosition(c.JD, pp.Moon, site)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !testutils.CheckTolerance(zabinski.FindSignedDiff(λ, c.Lon), 0, testutils.SecondTolerance) {
+			t.Errorf("ingress %d: longitude %v, want %v", i, λ.Deg(), c.Lon.Deg())
+		}
+	}
+}
+
+func TestLunations(t *testing.T) {
+	// Moon phases of January 2022, in UT.
+	published := []struct {
+		angle float64
+		jd    float64
+	}{
+		{0, julian.CalendarGregorianToJD(2022, 1, 2+(18+33./60)/24)},
+		{90, julian.CalendarGregorianToJD(2022, 1, 9+(18+11./60)/24)},
+		{180, julian.CalendarGregorianToJD(2022, 1, 17+(23+48./60)/24)},
+		{270, julian.CalendarGregorianToJD(2022, 1, 25+(13+41./60)/24)},
+	}
+	for _, p := range published {
+		found, err := crossing.FindSeparation(pp.Moon, pp.Sun, unit.AngleFromDeg(p.angle), jan2022, jan2022+28, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != 1 {
+			t.Fatalf("%v°: found %d, want 1", p.angle, len(found))
+		}
+		if !testutils.CheckTolerance(found[0].JD, p.jd, 5*testutils.JulianMinuteTolerance) {
+			t.Errorf("%v°: JD %v, want %v", p.angle, found[0].JD, p.jd)
+		}
+	}
+}
+
+func TestAspectBothSides(t *testing.T) {
+	// Both quarters are squares.
+	found, err := crossing.FindAspect(pp.Moon, pp.Sun, unit.AngleFromDeg(90), jan2022, jan2022+28, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("found %d squares, want 2", len(found))
+	}
+	if found[0].Lon.Deg() > found[1].Lon.Deg() {
+		t.Error("expected the waxing square first")
+	}
+}
+
+func TestRetrogradeSeparation(t *testing.T) {
+	// Mercury meets the Sun at inferior conjunction in January 2022, while retrograde,
+	// and again at superior conjunction in April.
+	found, err := crossing.FindAspect(pp.Mercury, pp.Sun, unit.AngleFromDeg(0), jan2022, jan2022+120, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("found %d conjunctions, want 2", len(found))
+	}
+	if !found[0].Retrograde || found[1].Retrograde {
+		t.Error("expected the separation to shrink at inferior conjunction only")
+	}
+	for i, c := range found {
+		λA, _, _, _ := web.FindPosition(c.JD, pp.Mercury, nil)
+		λB, _, _, _ := web.FindPosition(c.JD, pp.Sun, nil)
+		if !testutils.CheckTolerance(zabinski.FindSignedDiff(λA, λB), 0, testutils.SecondTolerance) {
+			t.Errorf("hit %d: separation %v", i, zabinski.FindSignedDiff(λA, λB))
+		}
+	}
+}
+
+func TestPrecessed(t *testing.T) {
+	// A decade on, the Sun reaches 0° Aries plus the precession since 2012, some three and a half hours after the equinox.
+	epoch := julian.CalendarGregorianToJD(2012, 1, 1)
+	equinox, err := crossing.Find(pp.Sun, 0, jan2022, jan2022+365, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found, err := crossing.FindPrecessed(pp.Sun, 0, epoch, jan2022, jan2022+365, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || len(equinox) != 1 {
+		t.Fatalf("found %d and %d crossings, want 1", len(found), len(equinox))
+	}
+	λ, _, _, _ := web.FindPosition(found[0].JD, pp.Sun, nil)
+	if !testutils.CheckTolerance(λ.Deg(), ayanamsa.Precession(epoch, found[0].JD).Deg(), testutils.SecondTolerance) {
+		t.Errorf("longitude %v", λ.Deg())
+	}
+	if late := (found[0].JD - equinox[0].JD) * 24; late < 3 || late > 4 {
+		t.Errorf("%v hours after the equinox", late)
+	}
+}
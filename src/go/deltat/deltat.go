@@ -0,0 +1,104 @@
+// Deltat: Chapter 10, Dynamical Time and Universal Time.
+//
+// ΔT = TT − UT is evaluated with the polynomial expressions of Espenak and
+// Meeus, "Five Millennium Canon of Solar Eclipses" (NASA/TP-2006-214141).
+// The expressions are fitted piecewise to the historical record from -500
+// to 2005.  Outside of that range they extrapolate with the long-term
+// parabola of Morrison and Stephenson, blended in to the end of the modern
+// fit between 2050 and 2150.
+//
+// Theories in this library (VSOP87, ELP as given by Meeus, nutation) take
+// JDE, while calendar input and sidereal time are in UT.  Use JDE to go from
+// one to the other.
+package deltat
+
+import (
+	"math"
+
+	base "webeph/base"
+	unit "webeph/unit"
+)
+
+// span is one piece of the Espenak-Meeus fit.
+//
+// ΔT for a decimal year y in [from, to) is the polynomial c evaluated at
+// (y - epoch) / scale.
+type span struct {
+	from, to     float64
+	epoch, scale float64
+	c            []float64
+}
+
+var spans = []span{
+	{-500, 500, 0, 100, []float64{10583.6, -1014.41, 33.78311, -5.952053,
+		-0.1798452, 0.022174192, 0.0090316521}},
+	{500, 1600, 1000, 100, []float64{1574.2, -556.01, 71.23472, 0.319781,
+		-0.8503463, -0.005050998, 0.0083572073}},
+	{1600, 1700, 1600, 1, []float64{120, -0.9808, -0.01532, 1. / 7129}},
+	{1700, 1800, 1700, 1, []float64{8.83, 0.1603, -0.0059285, 0.00013336,
+		-1. / 1174000}},
+	{1800, 1860, 1800, 1, []float64{13.72, -0.332447, 0.0068612, 0.0041116,
+		-0.00037436, 0.0000121272, -0.0000001699, 0.000000000875}},
+	{1860, 1900, 1860, 1, []float64{7.62, 0.5737, -0.251754, 0.01680668,
+		-0.0004473624, 1. / 233174}},
+	{1900, 1920, 1900, 1, []float64{-2.79, 1.494119, -0.0598939, 0.0061966,
+		-0.000197}},
+	{1920, 1941, 1920, 1, []float64{21.20, 0.84493, -0.076100, 0.0020936}},
+	{1941, 1961, 1950, 1, []float64{29.07, 0.407, -1. / 233, 1. / 2547}},
+	{1961, 1986, 1975, 1, []float64{45.45, 1.067, -1. / 260, -1. / 718}},
+	{1986, 2005, 2000, 1, []float64{63.86, 0.3345, -0.060374, 0.0017275,
+		0.000651814, 0.00002373599}},
+	{2005, 2050, 2000, 1, []float64{62.92, 0.32217, 0.005589}},
+}
+
+// longTerm is the parabola of Morrison and Stephenson used outside of the
+// fitted record.
+func longTerm(y float64) float64 {
+	u := (y - 1820) / 100
+	return -20 + 32*u*u
+}
+
+// PolyEspenakMeeus returns ΔT for a decimal year y.
+//
+// A decimal year is a year plus the fraction of the year elapsed, so that
+// the middle of January 2000 is about 2000.04.
+func PolyEspenakMeeus(y float64) unit.Time {
+	switch {
+	case y < spans[0].from:
+		return unit.Time(longTerm(y))
+	case y >= 2150:
+		return unit.Time(longTerm(y))
+	case y >= 2050:
+		// blend from the end of the modern fit into the long-term parabola
+		return unit.Time(longTerm(y) - 0.5628*(2150-y))
+	}
+	for i := range spans {
+		s := &spans[i]
+		if y < s.to {
+			return unit.Time(base.Horner((y-s.epoch)/s.scale, s.c...))
+		}
+	}
+	// not reached: the last span ends at 2050
+	return unit.Time(math.NaN())
+}
+
+// DeltaT returns ΔT for the Julian day jd.
+//
+// The argument may be in either UT or TT.  ΔT changes by well under a
+// second over a day, so the difference between the two does not matter here.
+func DeltaT(jd float64) unit.Time {
+	return PolyEspenakMeeus(base.JDEToJulianYear(jd))
+}
+
+// JDE converts a Julian day in UT to a Julian ephemeris day (TT).
+func JDE(jd float64) float64 {
+	return jd + DeltaT(jd).Day()
+}
+
+// JD converts a Julian ephemeris day (TT) to a Julian day in UT.
+func JD(jde float64) float64 {
+	// ΔT is evaluated at the UT estimate, as JDE does, so that JD inverts
+	// JDE to within rounding.  Two passes are plenty.
+	jd := jde - DeltaT(jde).Day()
+	return jde - DeltaT(jd).Day()
+}
@@ -0,0 +1,76 @@
+package deltat_test
+
+import (
+	"fmt"
+	"testing"
+
+	deltat "webeph/deltat"
+	julian "webeph/julian"
+	testutils "webeph/testutils"
+)
+
+// Tests ΔT against the values tabulated by Espenak and Meeus.
+// Receives:
+//	t: the testing platform
+// Returns:
+//	nothing
+// Notes:
+//	The polynomials are fitted to the table, so agreement is a few seconds at worst in the historical record
+//	and fractions of a second in the modern record.
+func TestPolyEspenakMeeus(t *testing.T) {
+	testCases := []struct {
+		y         float64
+		expected  float64
+		tolerance float64
+	}{
+		{-500, 17190, 20},
+		{0, 10580, 10},
+		{500, 5710, 10},
+		{1000, 1570, 10},
+		{1500, 200, 10},
+		{1600, 120, 1},
+		{1700, 9, 1},
+		{1800, 14, 1},
+		{1900, -3, 1},
+		{1950, 29.1, 0.5},
+		{1980, 50.5, 0.5},
+		{2000, 63.8, 0.5},
+		{2005, 64.7, 0.5},
+	}
+	for _, tc := range testCases {
+		t.Run(
+			fmt.Sprintf("ΔT for %v", tc.y),
+			func(t *testing.T) {
+				got := deltat.PolyEspenakMeeus(tc.y).Sec()
+				if !testutils.CheckTolerance(got, tc.expected, tc.tolerance) {
+					t.Errorf("expected %v to be %v", got, tc.expected)
+				}
+			},
+		)
+	}
+}
+
+func TestPolyContinuity(t *testing.T) {
+	// Neighbouring pieces of the fit should meet to within a couple of seconds.
+	for _, y := range []float64{-500, 500, 1600, 1700, 1800, 1860, 1900, 1920, 1941, 1961, 1986, 2005, 2050, 2150} {
+		before := deltat.PolyEspenakMeeus(y - 1e-9).Sec()
+		after := deltat.PolyEspenakMeeus(y).Sec()
+		if !testutils.CheckTolerance(before, after, 2) {
+			t.Errorf("TestPolyContinuity: %v: %v before, %v after", y, before, after)
+		}
+	}
+}
+
+func TestJDE(t *testing.T) {
+	// Example 10.a, p. 78: 1977 February 18, 3h37m40s TD, ΔT = 48s.
+	jde := julian.CalendarGregorianToJD(1977, 2, 18+(3+37./60+40./3600)/24)
+	jd := deltat.JD(jde)
+	expected := 48.
+	got := (jde - jd) * 86400
+	if !testutils.CheckTolerance(got, expected, 1) {
+		t.Errorf("TestJDE: expected ΔT %v to be %v", got, expected)
+	}
+	if back := deltat.JDE(jd); !testutils.CheckTolerance(back, jde, 1e-9) {
+		t.Errorf("TestJDE: expected round trip %v to be %v", back, jde)
+	}
+}
@@ -0,0 +1,281 @@
+// Eclipse: Chapter 54, Eclipses.
+//
+// Instead of the series of chapter 54, each eclipse is measured from the
+// shadow geometry at the time of greatest eclipse.  Apparent positions come
+// from moonposition.Position and solar.ApparentLongitude, so results carry
+// their accuracy: greatest eclipse to about a minute, magnitude and gamma
+// to a few thousandths.
+package eclipse
+
+import (
+	"math"
+
+	base "webeph/base"
+	deltat "webeph/deltat"
+	iterate "webeph/iterate"
+	moonphase "webeph/moonphase"
+	moonposition "webeph/moonposition"
+	nutation "webeph/nutation"
+	solar "webeph/solar"
+	unit "webeph/unit"
+)
+
+// Eclipse type constants.
+const (
+	Penumbral = iota // lunar only: the Moon touches the penumbra alone
+	Partial
+	Annular // solar only
+	Total
+	Hybrid // solar only: total at greatest eclipse, annular at the ends of the path
+)
+
+// Eclipse is a solar or lunar eclipse.
+type Eclipse struct {
+	JD        float64 // greatest eclipse, as a Julian day in UT
+	Solar     bool    // true for a solar eclipse, false for a lunar one
+	Type      int     // one of the type constants
+	Central   bool    // solar only: the shadow axis meets the Earth
+	Magnitude float64 // solar: fraction of the Sun's diameter covered; lunar: umbral magnitude
+	Penumbral float64 // lunar only: penumbral magnitude
+	Gamma     float64 // least distance of the shadow axis from the center of the Earth (solar) or of the Moon (lunar), in Earth radii, positive to the north
+}
+
+const (
+	// Equatorial radius of the Earth, in km.
+	earthRadius = 6378.14
+	// Radii of the Moon and Sun, in Earth radii.
+	moonRadius = 0.2725076
+	sunRadius  = 696000. / earthRadius
+	// Polar flattening of the Earth makes the shadow axis miss it from 0.9972 radii, on average (p. 381).
+	earthLimb = 0.9972
+	// Enlargement of the Earth's shadow by its atmosphere, after Danjon: 1% on the Earth's parallax only.
+	shadowEnlargement = 1.01
+	// Ratio of the Earth's mean radius toward the Moon to its equatorial radius.
+	earthOblateness = 0.998340
+	// If |sin F| exceeds this at syzygy there is no eclipse (p. 380).
+	nodeLimit = 0.36
+	// Half width of the bracket around syzygy for greatest eclipse, in days.
+	bracket = .25
+	// Width of the final bracket, in days: about a second.
+	tolerance = 1. / 86400
+	// Step for the derivative of the axis distance, in days.
+	step = 1e-4
+)
+
+// Finds every solar and lunar eclipse between two dates.
+// Receives:
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+// Returns:
+//	eclipses: the eclipses found, in order of time
+// Notes:
+//	Each new and full moon from moonphase.Find is screened with the argument of latitude of the Moon,
+//	measured from moonposition.FindAscendingNode, then measured at greatest eclipse.
+//	Lunar magnitudes follow Danjon's enlargement of the Earth's shadow, as in the NASA canons, rather than
+//	Chauvenet's 1/50 used by Meeus. Umbral magnitudes come out about 0.01 smaller.
+func Find(jdStart, jdEnd float64) (eclipses []Eclipse) {
+	for _, event := range moonphase.Find(jdStart, jdEnd) {
+		if event.Phase != moonphase.New && event.Phase != moonphase.Full {
+			continue
+		}
+		jde := deltat.JDE(event.JD)
+		λ, _, _ := moonposition.Position(jde)
+		F := λ - unit.AngleFromDeg(moonposition.FindAscendingNode(event.JD))
+		if math.Abs(F.Sin()) > nodeLimit {
+			continue
+		}
+		var e Eclipse
+		var ok bool
+		if event.Phase == moonphase.New {
+			e, ok = findSolar(jde)
+		} else {
+			e, ok = findLunar(jde)
+		}
+		if ok {
+			eclipses = append(eclipses, e)
+		}
+	}
+	return
+}
+
+// Measures a solar eclipse near a new moon.
+// Receives:
+//	jde: the new moon, as a Julian ephemeris day
+// Returns:
+//	e: the eclipse
+//	ok: false if the penumbra misses the Earth
+func findSolar(jde float64) (e Eclipse, ok bool) {
+	jde = greatest(jde, true)
+	sun, moon := positions(jde)
+	axis := sub(moon, sun)
+	D := norm(axis)
+	axis = scale(axis, 1/D)
+	γ := offset(moon, axis)
+	// distance of the Moon from the fundamental plane, through the center of the Earth
+	z := -dot(moon, axis)
+	// half angles of the penumbral and umbral cones
+	f1 := math.Asin((sunRadius + moonRadius) / D)
+	f2 := math.Asin((sunRadius - moonRadius) / D)
+	// radii in the fundamental plane, in Earth radii; the umbral radius is negative past its vertex
+	l1 := z*math.Tan(f1) + moonRadius/math.Cos(f1)
+	l2 := moonRadius/math.Cos(f2) - z*math.Tan(f2)
+	absγ := math.Abs(γ)
+	if absγ > earthLimb+l1 {
+		return
+	}
+	e = Eclipse{JD: deltat.JD(jde), Solar: true, Gamma: γ}
+	switch {
+	case absγ < earthLimb:
+		// The observer at greatest eclipse stands ζ above the fundamental plane.
+		ζ := math.Sqrt(1 - γ*γ)
+		L1 := l1 - ζ*math.Tan(f1)
+		L2 := l2 + ζ*math.Tan(f2)
+		e.Central = true
+		e.Magnitude = (L1 + L2) / (L1 - L2)
+		switch {
+		case L2 > 0 && l2 < 0:
+			e.Type = Hybrid
+		case L2 > 0:
+			e.Type = Total
+		default:
+			e.Type = Annular
+		}
+	default:
+		// (p. 382)
+		e.Magnitude = (l1 + earthLimb - absγ) / (l1 - l2)
+		switch {
+		case absγ >= earthLimb+math.Abs(l2):
+			e.Type = Partial
+		case l2 > 0:
+			e.Type = Total
+		default:
+			e.Type = Annular
+		}
+	}
+	return e, true
+}
+
+// Measures a lunar eclipse near a full moon.
+// Receives:
+//	jde: the full moon, as a Julian ephemeris day
+// Returns:
+//	e: the eclipse
+//	ok: false if the Moon misses the penumbra
+func findLunar(jde float64) (e Eclipse, ok bool) {
+	jde = greatest(jde, false)
+	sun, moon := positions(jde)
+	R := norm(sun)
+	axis := scale(sun, -1/R)
+	γ := offset(moon, axis)
+	Δ := norm(moon)
+	πMoon := moonposition.Parallax(Δ * earthRadius).Rad()
+	sMoon := math.Asin(moonRadius / Δ)
+	πSun := math.Asin(1 / R)
+	sSun := math.Asin(sunRadius / R)
+	// shadow radii and the Moon's distance from the axis, as seen from the Earth
+	πEarth := shadowEnlargement * earthOblateness * πMoon
+	ρ := πEarth + πSun + sSun
+	σ := πEarth + πSun - sSun
+	m := math.Atan(math.Abs(γ) / dot(moon, axis))
+	e = Eclipse{
+		JD:        deltat.JD(jde),
+		Gamma:     γ,
+		Magnitude: (σ - m + sMoon) / (2 * sMoon),
+		Penumbral: (ρ - m + sMoon) / (2 * sMoon),
+	}
+	switch {
+	case e.Penumbral <= 0:
+		return
+	case e.Magnitude >= 1:
+		e.Type = Total
+	case e.Magnitude > 0:
+		e.Type = Partial
+	default:
+		e.Type = Penumbral
+	}
+	return e, true
+}
+
+// Finds greatest eclipse, when the shadow axis passes closest to the center of the Earth (solar)
+// or of the Moon (lunar).
+// Receives:
+//	jde: the syzygy, as a Julian ephemeris day
+//	solarEclipse: true for a solar eclipse
+// Returns:
+//	the time of greatest eclipse, as a Julian ephemeris day
+func greatest(jde float64, solarEclipse bool) float64 {
+	distance := func(jde float64) float64 {
+		sun, moon := positions(jde)
+		var axis [3]float64
+		if solarEclipse {
+			axis = sub(moon, sun)
+		} else {
+			axis = scale(sun, -1)
+		}
+		return math.Abs(offset(moon, scale(axis, 1/norm(axis))))
+	}
+	return iterate.BinaryRoot(func(jde float64) float64 {
+		return distance(jde+step) - distance(jde-step)
+	}, jde-bracket, jde+bracket, tolerance)
+}
+
+// Finds geocentric apparent positions of the Sun and Moon.
+// Receives:
+//	jde: the Julian ephemeris day
+// Returns:
+//	sun, moon: rectangular equatorial coordinates, referred to the true equator and equinox of date, in Earth radii
+func positions(jde float64) (sun, moon [3]float64) {
+	T := base.J2000Century(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	ε := nutation.MeanObliquity(jde) + Δε
+	sun = rectangular(solar.ApparentLongitude(T), 0, solar.Radius(T)*base.AU/earthRadius, ε)
+	λ, β, Δ := moonposition.Position(jde)
+	moon = rectangular(λ+Δψ, β, Δ/earthRadius, ε)
+	return
+}
+
+// Converts ecliptic coordinates to rectangular equatorial ones.
+func rectangular(λ, β unit.Angle, r float64, ε unit.Angle) [3]float64 {
+	sλ, cλ := λ.Sincos()
+	sβ, cβ := β.Sincos()
+	sε, cε := ε.Sincos()
+	x, y, z := r*cβ*cλ, r*cβ*sλ, r*sβ
+	return [3]float64{x, y*cε - z*sε, y*sε + z*cε}
+}
+
+// Finds the signed distance between the center of the Earth and a line through a point.
+// Receives:
+//	p: a point on the line
+//	axis: the unit vector along the line
+// Returns:
+//	the distance, positive when the line passes north of the center of the Earth
+// Notes:
+//	For a lunar eclipse the line runs through the center of the Earth instead, and p is the Moon:
+//	the distance is then positive when the Moon passes north of the line.
+func offset(p, axis [3]float64) float64 {
+	// closest point of the line, as seen in the plane normal to it
+	c := sub(p, scale(axis, dot(p, axis)))
+	// north in that plane
+	north := sub([3]float64{0, 0, 1}, scale(axis, axis[2]))
+	d := norm(c)
+	if dot(c, north) < 0 {
+		return -d
+	}
+	return d
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func norm(a [3]float64) float64 {
+	return math.Sqrt(dot(a, a))
+}
+
+func sub(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+func scale(a [3]float64, s float64) [3]float64 {
+	return [3]float64{a[0] * s, a[1] * s, a[2] * s}
+}
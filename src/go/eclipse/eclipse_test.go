@@ -0,0 +1,128 @@
+package eclipse_test
+
+import (
+	"testing"
+
+	eclipse "webeph/eclipse"
+	globe "webeph/globe"
+	julian "webeph/julian"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+)
+
+// Greatest eclipse in UT, gamma and magnitude from the NASA eclipse pages.
+var published = []struct {
+	name      string
+	jd        float64
+	solar     bool
+	typ       int
+	magnitude float64
+	gamma     float64
+}{
+	{"1993 May 21, Example 54.a", julian.CalendarGregorianToJD(1993, 5, 21+(14+19./60)/24), true, eclipse.Partial, .7352, 1.1364},
+	{"2017 August 21", julian.CalendarGregorianToJD(2017, 8, 21+(18+25.5/60)/24), true, eclipse.Total, 1.0306, .4367},
+	{"2021 June 10", julian.CalendarGregorianToJD(2021, 6, 10+(10+41.9/60)/24), true, eclipse.Annular, .9435, .9152},
+	{"2023 April 20", julian.CalendarGregorianToJD(2023, 4, 20+(4+16.8/60)/24), true, eclipse.Hybrid, 1.0132, -.3952},
+	{"2024 April 8", julian.CalendarGregorianToJD(2024, 4, 8+(18+17.3/60)/24), true, eclipse.Total, 1.0566, .3431},
+	{"2019 January 21", julian.CalendarGregorianToJD(2019, 1, 21+(5+12.2/60)/24), false, eclipse.Total, 1.1953, .3684},
+	{"2020 July 5", julian.CalendarGregorianToJD(2020, 7, 5+(4+29.9/60)/24), false, eclipse.Penumbral, -.6436, -1.3639},
+	{"2022 November 8", julian.CalendarGregorianToJD(2022, 11, 8+(10+59.2/60)/24), false, eclipse.Total, 1.3589, .2570},
+}
+
+func TestPublished(t *testing.T) {
+	for _, p := range published {
+		found := eclipse.Find(p.jd-1, p.jd+1)
+		if len(found) != 1 {
+			t.Errorf("%s: found %d eclipses, want 1", p.name, len(found))
+			continue
+		}
+		e := found[0]
+		if e.Solar != p.solar || e.Type != p.typ {
+			t.Errorf("%s: solar %v type %d, want solar %v type %d", p.name, e.Solar, e.Type, p.solar, p.typ)
+		}
+		if !testutils.CheckTolerance(e.JD, p.jd, testutils.JulianMinuteTolerance) {
+			t.Errorf("%s: JD %v, want %v", p.name, e.JD, p.jd)
+		}
+		if !testutils.CheckTolerance(e.Magnitude, p.magnitude, .005) {
+			t.Errorf("%s: magnitude %.4f, want %.4f", p.name, e.Magnitude, p.magnitude)
+		}
+		if !testutils.CheckTolerance(e.Gamma, p.gamma, .002) {
+			t.Errorf("%s: gamma %.4f, want %.4f", p.name, e.Gamma, p.gamma)
+		}
+	}
+}
+
+func TestYear2022(t *testing.T) {
+	// Two partial solar eclipses and two total lunar ones.
+	want := []struct {
+		solar bool
+		typ   int
+	}{
+		{true, eclipse.Partial},
+		{false, eclipse.Total},
+		{true, eclipse.Partial},
+		{false, eclipse.Total},
+	}
+	found := eclipse.Find(julian.CalendarGregorianToJD(2022, 1, 1), julian.CalendarGregorianToJD(2023, 1, 1))
+	if len(found) != len(want) {
+		t.Fatalf("found %d eclipses, want %d", len(found), len(want))
+	}
+	for i, w := range want {
+		if found[i].Solar != w.solar || found[i].Type != w.typ {
+			t.Errorf("eclipse %d: solar %v type %d, want solar %v type %d", i, found[i].Solar, found[i].Type, w.solar, w.typ)
+		}
+	}
+}
+
+func TestLocalDallas(t *testing.T) {
+	// Dallas, 2024 April 8. Published contacts, in UT.
+	day := julian.CalendarGregorianToJD(2024, 4, 8)
+	g := &globe.Coord{Lat: unit.AngleFromDeg(32.7767), Lon: unit.AngleFromDeg(96.797)}
+	l, ok := eclipse.FindLocal(eclipse.Find(day, day+1)[0], g, 0)
+	if !ok || l.Type != eclipse.Total {
+		t.Fatalf("ok %v type %d, want a total eclipse", ok, l.Type)
+	}
+	for _, c := range []struct {
+		name string
+		got  eclipse.Contact
+		want float64
+	}{
+		{"C1", l.C1, day + (17+23./60+8./3600)/24},
+		{"C2", l.C2, day + (18+40./60+43./3600)/24},
+		{"C3", l.C3, day + (18+44./60+35./3600)/24},
+		{"C4", l.C4, day + (20+2./60+49./3600)/24},
+	} {
+		if !testutils.CheckTolerance(c.got.JD, c.want, testutils.JulianMinuteTolerance) {
+			t.Errorf("%s: JD %v, want %v", c.name, c.got.JD, c.want)
+		}
+	}
+	if l.Max.JD <= l.C2.JD || l.Max.JD >= l.C3.JD {
+		t.Errorf("maximum %v outside totality", l.Max.JD)
+	}
+	if l.Obscuration != 1 || l.Max.Altitude.Deg() < 60 {
+		t.Errorf("obscuration %v altitude %v", l.Obscuration, l.Max.Altitude.Deg())
+	}
+}
+
+func TestLocalLondon(t *testing.T) {
+	// London, 2015 March 20: partial, magnitude 0.87, obscuration 85%, maximum at 9ʰ31ᵐ UT.
+	day := julian.CalendarGregorianToJD(2015, 3, 20)
+	g := &globe.Coord{Lat: unit.AngleFromDeg(51.5074), Lon: unit.AngleFromDeg(.1278)}
+	l, ok := eclipse.FindLocal(eclipse.Find(day, day+1)[0], g, 0)
+	if !ok || l.Type != eclipse.Partial || l.C2.JD != 0 || l.C3.JD != 0 {
+		t.Fatalf("ok %v type %d, want a partial eclipse", ok, l.Type)
+	}
+	if !testutils.CheckTolerance(l.Max.JD, day+(9+31./60)/24, 2*testutils.JulianMinuteTolerance) {
+		t.Errorf("maximum %v", l.Max.JD)
+	}
+	if !testutils.CheckTolerance(l.Magnitude, .87, .01) || !testutils.CheckTolerance(l.Obscuration, .85, .01) {
+		t.Errorf("magnitude %v obscuration %v", l.Magnitude, l.Obscuration)
+	}
+}
+
+func TestLocalLunar(t *testing.T) {
+	day := julian.CalendarGregorianToJD(2022, 11, 8)
+	if _, ok := eclipse.FindLocal(eclipse.Find(day, day+1)[0], &globe.Coord{}, 0); ok {
+		t.Error("lunar eclipse has no local solar circumstances")
+	}
+}
@@ -0,0 +1,153 @@
+package eclipse
+
+import (
+	"math"
+
+	deltat "webeph/deltat"
+	globe "webeph/globe"
+	iterate "webeph/iterate"
+	nutation "webeph/nutation"
+	sidereal "webeph/sidereal"
+	unit "webeph/unit"
+)
+
+// Contact is a moment of a solar eclipse seen from one place.
+type Contact struct {
+	JD       float64    // Julian day, in UT; zero if the contact does not happen here
+	Altitude unit.Angle // true altitude of the center of the Sun
+}
+
+// Local is a solar eclipse seen from one place.
+type Local struct {
+	Type        int     // Partial, Annular or Total, as seen from this place
+	C1          Contact // first contact: the partial phase begins
+	C2          Contact // second contact: the total or annular phase begins
+	Max         Contact // maximum eclipse
+	C3          Contact // third contact: the total or annular phase ends
+	C4          Contact // fourth contact: the partial phase ends
+	Magnitude   float64 // fraction of the Sun's diameter covered at maximum
+	Obscuration float64 // fraction of the Sun's disk covered at maximum
+}
+
+// Finds the local circumstances of a solar eclipse.
+// Receives:
+//	e: the eclipse, as found by Find
+//	g: the observer, as a globe.Coord with longitude measured westward
+//	h: the height above mean sea level, in meters
+// Returns:
+//	l: the local circumstances
+//	ok: false for a lunar eclipse, or if the Moon does not touch the Sun from this place
+// Notes:
+//	Contacts come from the topocentric separation and semidiameters of the Sun and Moon, so parallax is exact
+//	for the place rather than taken from Besselian elements. Contacts are found whether or not the Sun is up:
+//	check the altitudes.
+func FindLocal(e Eclipse, g *globe.Coord, h float64) (l Local, ok bool) {
+	if !e.Solar {
+		return
+	}
+	o := observer{g: g, h: h}
+	start, end := e.JD-bracket, e.JD+bracket
+	max := iterate.BinaryRoot(func(jd float64) float64 {
+		s0, _, _ := o.disks(jd - step)
+		s1, _, _ := o.disks(jd + step)
+		return s1 - s0
+	}, start, end, tolerance)
+	s, rSun, rMoon := o.disks(max)
+	if s >= rSun+rMoon {
+		return
+	}
+	l.Max = o.contact(max)
+	l.Magnitude = (rSun + rMoon - s) / (2 * rSun)
+	l.Obscuration = obscuration(s, rSun, rMoon)
+	// each contact is a root of the separation less the sum or difference of the semidiameters
+	outer := func(jd float64) float64 {
+		s, rSun, rMoon := o.disks(jd)
+		return s - (rSun + rMoon)
+	}
+	inner := func(jd float64) float64 {
+		s, rSun, rMoon := o.disks(jd)
+		return s - math.Abs(rSun-rMoon)
+	}
+	l.C1 = o.contact(iterate.BinaryRoot(outer, start, max, tolerance))
+	l.C4 = o.contact(iterate.BinaryRoot(outer, max, end, tolerance))
+	l.Type = Partial
+	if s < math.Abs(rSun-rMoon) {
+		l.C2 = o.contact(iterate.BinaryRoot(inner, start, max, tolerance))
+		l.C3 = o.contact(iterate.BinaryRoot(inner, max, end, tolerance))
+		l.Type = Annular
+		if rMoon > rSun {
+			l.Type = Total
+		}
+	}
+	return l, true
+}
+
+// observer is a place on the Earth.
+type observer struct {
+	g *globe.Coord
+	h float64
+}
+
+// Finds the topocentric geometry of the Sun and Moon.
+// Receives:
+//	jd: the Julian day, in UT
+// Returns:
+//	s: the separation of the centers, in radians
+//	rSun, rMoon: the semidiameters, in radians
+//	sun: the unit vector to the Sun, in rectangular equatorial coordinates
+//	zenith: the unit vector to the zenith, in the same coordinates
+func (o observer) geometry(jd float64) (s, rSun, rMoon float64, sun, zenith [3]float64) {
+	jde := deltat.JDE(jd)
+	geoSun, geoMoon := positions(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	// local apparent sidereal time; the longitude is measured westward
+	θ := sidereal.Apparent(Δψ, Δε, jd).Angle() - o.g.Lon
+	sθ, cθ := θ.Sincos()
+	S, C := globe.Earth76.ParallaxConstants(o.g.Lat, o.h)
+	site := [3]float64{C * cθ, C * sθ, S}
+	sun = sub(geoSun, site)
+	moon := sub(geoMoon, site)
+	dSun, dMoon := norm(sun), norm(moon)
+	sun = scale(sun, 1/dSun)
+	moon = scale(moon, 1/dMoon)
+	s = math.Atan2(norm(cross(sun, moon)), dot(sun, moon))
+	rSun = math.Asin(sunRadius / dSun)
+	rMoon = math.Asin(moonRadius / dMoon)
+	sφ, cφ := o.g.Lat.Sincos()
+	zenith = [3]float64{cφ * cθ, cφ * sθ, sφ}
+	return
+}
+
+func (o observer) disks(jd float64) (s, rSun, rMoon float64) {
+	s, rSun, rMoon, _, _ = o.geometry(jd)
+	return
+}
+
+func (o observer) contact(jd float64) Contact {
+	_, _, _, sun, zenith := o.geometry(jd)
+	return Contact{JD: jd, Altitude: unit.Angle(math.Asin(dot(sun, zenith)))}
+}
+
+// Finds the fraction of the Sun's disk covered by the Moon's.
+// Receives:
+//	s: the separation of the centers
+//	rSun, rMoon: the semidiameters, in the unit of s
+// Returns:
+//	the fraction covered, from 0 to 1
+func obscuration(s, rSun, rMoon float64) float64 {
+	switch {
+	case s >= rSun+rMoon:
+		return 0
+	case s <= math.Abs(rSun-rMoon):
+		return math.Min(1, rMoon*rMoon/(rSun*rSun))
+	}
+	// area of the lens where the disks overlap
+	a := rMoon*rMoon*math.Acos((s*s+rMoon*rMoon-rSun*rSun)/(2*s*rMoon)) +
+		rSun*rSun*math.Acos((s*s+rSun*rSun-rMoon*rMoon)/(2*s*rSun)) -
+		math.Sqrt((-s+rMoon+rSun)*(s+rMoon-rSun)*(s-rMoon+rSun)*(s+rMoon+rSun))/2
+	return a / (math.Pi * rSun * rSun)
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
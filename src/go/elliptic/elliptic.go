@@ -10,6 +10,7 @@ import (
 	apparent "webeph/apparent"
 	base "webeph/base"
 	pp "webeph/planetposition"
+	pluto "webeph/pluto"
 	schlyter "webeph/schlyter"
 	unit "webeph/unit"
 )
@@ -24,6 +25,10 @@ import (
 //	B: heliocentric ecliptic latitude, as an Angle
 //	R: distance, in AU
 func findHeliocentricPosition(p *pp.V87Planet, jde float64, useSchlyter bool) (L, B unit.Angle, R float64) {
+	// Pluto has no VSOP87 theory; use the series of chapter 37.
+	if p.Ibody == pp.Pluto {
+		return pluto.Position(jde)
+	}
 	if useSchlyter {
 		if p.Ibody == pp.Mercury {
 			return schlyter.HeliocentricMercury(jde)
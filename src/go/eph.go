@@ -1,6 +1,8 @@
 package main
 
 import (
+	// imported for its WASM exports
+	_ "webeph/returns"
 	unit "webeph/unit"
 	web "webeph/web"
 )
@@ -0,0 +1,149 @@
+// Heliacal: the risings and settings of stars and planets with respect to the Sun.
+//
+// A body near the Sun is lost in its light.  Its first rising seen in the
+// morning twilight is its heliacal rising, and its last setting seen in the
+// evening twilight its heliacal setting.  A body seen rising or setting is
+// taken as visible when the Sun is then at least the arcus visionis below
+// the horizon.
+//
+// Morning events come earlier each day against the Sun, so they are found as
+// the first day the body is seen; evening events as the last.
+package heliacal
+
+import (
+	"errors"
+
+	globe "webeph/globe"
+	rise "webeph/rise"
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+// Event constants.
+const (
+	HeliacalRising    = iota // first rising seen before sunrise
+	HeliacalSetting          // last setting seen after sunset
+	AcronychalRising         // last rising seen after sunset
+	AcronychalSetting        // last setting after sunset, without regard to visibility
+	CosmicRising             // first rising before sunrise, without regard to visibility
+	CosmicSetting            // first setting seen before sunrise
+)
+
+// How each event is found.
+var events = [...]struct {
+	rising   bool // the body rises, rather than sets
+	morning  bool // the event happens near sunrise, rather than sunset
+	apparent bool // the arcus visionis applies; otherwise the Sun need only be below the standard horizon
+}{
+	HeliacalRising:    {rising: true, morning: true, apparent: true},
+	HeliacalSetting:   {rising: false, morning: false, apparent: true},
+	AcronychalRising:  {rising: true, morning: false, apparent: true},
+	AcronychalSetting: {rising: false, morning: false, apparent: false},
+	CosmicRising:      {rising: true, morning: true, apparent: false},
+	CosmicSetting:     {rising: false, morning: true, apparent: true},
+}
+
+const (
+	// Days searched: past the synodic period of Mars, the longest of the planets.
+	maxDays = 800
+	// The largest change in the body's lead on the Sun from one day to the next, in days. A larger change is
+	// the body's event passing from one side of the night to the other, rather than past the Sun.
+	maxStep = .25
+)
+
+// Estimates the arcus visionis of a star.
+// Receives:
+//	mag: the visual magnitude
+// Returns:
+//	the depression of the Sun below the horizon at which the star is first seen, as a unit.Angle
+// Notes:
+//	A rough rule: 10° at magnitude zero, a degree more for each magnitude fainter. Pass a value of your own
+//	for a particular criterion.
+func ArcusVisionis(mag float64) unit.Angle {
+	return unit.AngleFromDeg(10 + mag)
+}
+
+// Finds the next heliacal event of a body.
+// Receives:
+//	event: one of the event constants
+//	position: the place of the body, as a function of the Julian day
+//	jd: the Julian day, in UT, to search from
+//	g: the observer, as a globe.Coord with longitude measured westward
+//	av: the arcus visionis, as a unit.Angle
+//	horizon: the horizon the body rises and sets on, or nil for rise.Standard
+// Returns:
+//	t: the Julian day, in UT, of the body's rising or setting on the day of the event; zero if none within 800 days
+//	err: an error for an unknown event
+// Notes:
+//	Sunrise and sunset come from zabinski.FindSunTimes: on the standard horizon, or on the altitude −av for dawn and dusk.
+//	The body's rising and setting come from rise.Find. Atmospheric extinction enters only through the arcus visionis.
+//	Inferior planets have no acronychal rising or cosmic setting, and far from the equator a star can have none of these
+//	events: t is zero.
+func Find(event int, position rise.Position, jd float64, g *globe.Coord, av unit.Angle, horizon *rise.Horizon) (t float64, err error) {
+	if event < 0 || event >= len(events) {
+		return 0, errors.New("Invalid heliacal event.")
+	}
+	e := events[event]
+	var sunHorizon *rise.Horizon
+	if e.apparent {
+		sunHorizon = &rise.Horizon{Altitude: -av}
+	}
+	// the body's lead on the Sun, and the time of its event, on the day before
+	var lead, prior float64
+	seen := false
+	for day := 0; day < maxDays; day++ {
+		x, tb, ok := findLead(e.rising, e.morning, position, jd+float64(day), g, sunHorizon, horizon)
+		if !ok {
+			seen = false
+			continue
+		}
+		if seen && x-lead < maxStep && lead-x < maxStep {
+			switch {
+			case e.morning && lead < 0 && x >= 0:
+				return tb, nil
+			case !e.morning && lead >= 0 && x < 0:
+				return prior, nil
+			}
+		}
+		lead, prior, seen = x, tb, true
+	}
+	return 0, nil
+}
+
+// Finds how far a body's rising or setting leads the Sun on one day.
+// Receives:
+//	rising: true for the body's rising, false for its setting
+//	morning: true to measure against sunrise, false against sunset
+//	position: the place of the body
+//	jd: a Julian day, in UT, within the local date
+//	g: the observer
+//	sunHorizon: the horizon for the Sun, or nil for rise.Standard
+//	horizon: the horizon for the body
+// Returns:
+//	x: the time, in days, by which the body's event falls in the dark: before sunrise, or after sunset
+//	t: the Julian day, in UT, of the body's event
+//	ok: false if the Sun or the body has no such event near that time of day
+func findLead(rising, morning bool, position rise.Position, jd float64, g *globe.Coord, sunHorizon, horizon *rise.Horizon) (x, t float64, ok bool) {
+	// zabinski measures longitude eastward.
+	sun := zabinski.FindSunTimes(jd, g.Lat, -g.Lon, sunHorizon)
+	ts := sun.Set
+	if morning {
+		ts = sun.Rise
+	}
+	if ts == 0 {
+		return 0, 0, false
+	}
+	// the body's event nearest the Sun's
+	body := rise.Find(position, ts-.5, g, horizon)
+	t = body.Set
+	if rising {
+		t = body.Rise
+	}
+	if t == 0 {
+		return 0, 0, false
+	}
+	if morning {
+		return ts - t, t, true
+	}
+	return t - ts, t, true
+}
@@ -0,0 +1,73 @@
+package heliacal_test
+
+import (
+	"testing"
+
+	heliacal "webeph/heliacal"
+	julian "webeph/julian"
+	pp "webeph/planetposition"
+	stars "webeph/stars"
+	unit "webeph/unit"
+	web "webeph/web"
+)
+
+var cairo = &web.Site{Lat: unit.AngleFromDeg(30.04), Lon: unit.AngleFromDeg(31.24)}
+
+// Memphis, for which the Sothic dates are usually reckoned.
+var memphis = &web.Site{Lat: unit.AngleFromDeg(29.85), Lon: unit.AngleFromDeg(31.25)}
+
+// The arcus visionis that Sirius needs at Memphis.
+var siriusAV = unit.AngleFromDeg(10)
+
+func TestSirius(t *testing.T) {
+	sirius, _ := stars.ByName("Sirius")
+	// AD 139, when Censorinus has the rising of Sirius fall on the Egyptian new year, 1 Thoth: July 20 (Julian).
+	// The other phases were computed apart, with the IAU 1976 precession and Meeus' Sun.
+	jd := julian.CalendarJulianToJD(139, 1, 1)
+	for _, c := range []struct {
+		event int
+		month int
+		day   float64
+	}{
+		{heliacal.HeliacalSetting, 5, 13},
+		{heliacal.AcronychalSetting, 5, 24},
+		{heliacal.CosmicRising, 7, 9},
+		{heliacal.HeliacalRising, 7, 20},
+		{heliacal.CosmicSetting, 12, 6},
+		{heliacal.AcronychalRising, 12, 23},
+	} {
+		found, err := web.FindStarHeliacal(c.event, jd, sirius, siriusAV, memphis)
+		if want := julian.CalendarJulianToJD(139, c.month, c.day); err != nil || !onDay(found, want) {
+			t.Errorf("event %d: %v, %v, want 139-%d-%v", c.event, julian.JDToCalendar(found), err, c.month, c.day)
+		}
+	}
+	// 1872 BC, as Parker dates year 7 of Senusret III, when a letter from Illahun announces the rising for IV Peret 16:
+	// July 17 (Julian).
+	jd = julian.CalendarJulianToJD(-1871, 1, 1)
+	found, err := web.FindStarHeliacal(heliacal.HeliacalRising, jd, sirius, siriusAV, memphis)
+	if want := julian.CalendarJulianToJD(-1871, 7, 17); err != nil || !onDay(found, want) {
+		t.Errorf("heliacal rising %v, %v, want -1871-7-17", julian.JDToCalendar(found), err)
+	}
+}
+
+// Checks that a time falls within a day of the date that starts at want.
+func onDay(found, want float64) bool {
+	return found >= want-1 && found < want+2
+}
+
+func TestVenus(t *testing.T) {
+	jd := julian.CalendarGregorianToJD(2022, 1, 1)
+	// Venus never stands opposite the Sun.
+	found, err := web.FindPlanetHeliacal(heliacal.AcronychalRising, jd, pp.Venus, unit.AngleFromDeg(5), cairo)
+	if err != nil || found != 0 {
+		t.Errorf("acronychal rising %v, %v", found, err)
+	}
+	// Morning first after the inferior conjunction of 2022 January 9.
+	found, _ = web.FindPlanetHeliacal(heliacal.HeliacalRising, jd, pp.Venus, unit.AngleFromDeg(5), cairo)
+	if found < jd || found > jd+20 {
+		t.Errorf("heliacal rising %v", julian.JDToCalendar(found))
+	}
+	if _, err := web.FindPlanetHeliacal(heliacal.CosmicSetting+1, jd, pp.Venus, 0, cairo); err == nil {
+		t.Error("an unknown event should be an error")
+	}
+}
@@ -0,0 +1,328 @@
+// Houses: house systems.
+//
+// All systems use geocentric latitude, like parallactic.FindAscendant and
+// williams.FindHouses, so that the first cusp of every quadrant system is the
+// same Ascendant found elsewhere in the library.
+package houses
+
+import (
+	"errors"
+	"math"
+
+	parallactic "webeph/parallactic"
+	unit "webeph/unit"
+	williams "webeph/williams"
+)
+
+// House system constants suitable for first argument to Find.
+const (
+	Regiomontanus = iota
+	Placidus
+	Koch
+	Campanus
+	Porphyry
+	Equal
+	WholeSign
+	Alcabitius
+	Morinus
+	Meridian
+	nSystems
+)
+
+var (
+	angle30  = unit.AngleFromDeg(30)
+	angle90  = unit.AngleFromDeg(90)
+	angle180 = unit.AngleFromDeg(180)
+
+	errSystem   = errors.New("Invalid house system.")
+	errLatitude = errors.New("House system undefined at this latitude.")
+)
+
+// Finds the twelve house cusps for a house system.
+// Receives:
+//	system: the house system, as one of the house system constants
+//	lst: local sidereal time, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+//	φ: geographic latitude, as a unit.Angle
+// Returns:
+//	cusps: the ecliptic longitudes of the cusps, house 1 first
+//	err: any errors encountered
+// Notes:
+//	Placidus and Koch divide semi-arcs, which do not exist for every degree of the ecliptic
+//	inside the polar circles. Those systems return an error there.
+func Find(system int, lst, ε, φ unit.Angle) (cusps [12]unit.Angle, err error) {
+	asc := parallactic.FindAscendant(ε, φ, lst)
+	mc := williams.FindMediumCoeli(lst, ε)
+	switch system {
+	case Regiomontanus:
+		// house 2, house 3, house 5, house 6, house 8, house 9, house 11, house 12
+		r := williams.FindHouses(lst, ε, φ)
+		return [12]unit.Angle{asc, r[0], r[1], mc.Add(angle180), r[2], r[3],
+			asc.Add(angle180), r[4], r[5], mc, r[6], r[7]}, nil
+	case Placidus:
+		return findPlacidus(asc, mc, lst, ε, williams.FindGeocentricLat(φ))
+	case Koch:
+		return findKoch(asc, mc, lst, ε, williams.FindGeocentricLat(φ))
+	case Campanus:
+		return findCampanus(asc, mc, lst, ε, williams.FindGeocentricLat(φ)), nil
+	case Porphyry:
+		return findPorphyry(asc, mc), nil
+	case Equal:
+		return findEqual(asc), nil
+	case WholeSign:
+		return findEqual(unit.AngleFromDeg(30 * math.Floor(asc.Deg()/30))), nil
+	case Alcabitius:
+		return findAlcabitius(asc, mc, lst, ε, williams.FindGeocentricLat(φ))
+	case Morinus:
+		return findMorinus(lst, ε), nil
+	case Meridian:
+		return findMeridian(lst, ε), nil
+	}
+	return cusps, errSystem
+}
+
+// Completes a quadrant system from its Ascendant, Midheaven and intermediate cusps.
+// Receives:
+//	asc: the Ascendant, as a unit.Angle
+//	mc: the Midheaven, as a unit.Angle
+//	h11, h12, h2, h3: the intermediate cusps of the eastern half, as unit.Angles
+// Returns:
+//	all twelve cusps, house 1 first
+func quadrants(asc, mc, h11, h12, h2, h3 unit.Angle) [12]unit.Angle {
+	return [12]unit.Angle{asc, h2, h3, mc.Add(angle180), h11.Add(angle180), h12.Add(angle180),
+		asc.Add(angle180), h2.Add(angle180), h3.Add(angle180), mc, h11, h12}
+}
+
+// Finds where the ecliptic crosses a circle through the north and south points of the horizon.
+// Receives:
+//	oa: the oblique ascension of the circle, as a unit.Angle
+//	sf, cf: sine and cosine of the pole of the circle
+//	ε: obliquity, as a unit.Angle
+// Returns:
+//	the ecliptic longitude of the crossing, as a unit.Angle
+// Notes:
+//	With the pole equal to latitude and oa = RAMC + 90°, this is the Ascendant. With a pole of zero,
+//	it is the ecliptic point whose right ascension is oa.
+func findObliqueAscendant(oa unit.Angle, sf, cf float64, ε unit.Angle) unit.Angle {
+	sε, cε := ε.Sincos()
+	so, co := oa.Sincos()
+	return unit.Angle(math.Atan2(so*cf, co*cf*cε-sf*sε)).Mod1()
+}
+
+// Finds the ecliptic point with a given right ascension.
+// Receives:
+//	α: right ascension, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+// Returns:
+//	the ecliptic longitude, as a unit.Angle
+func findLongitudeFromRA(α, ε unit.Angle) unit.Angle {
+	return findObliqueAscendant(α, 0, 1, ε)
+}
+
+// Finds the ascensional difference of an ecliptic point.
+// Receives:
+//	λ: ecliptic longitude, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+//	φ: geocentric latitude, as a unit.Angle
+// Returns:
+//	AD: the ascensional difference, as a unit.Angle
+//	ok: false if the point never rises or never sets
+func findAscensionalDifference(λ, ε, φ unit.Angle) (AD unit.Angle, ok bool) {
+	δ := math.Asin(ε.Sin() * λ.Sin())
+	s := math.Tan(δ) * φ.Tan()
+	if math.Abs(s) > 1 {
+		return 0, false
+	}
+	return unit.Angle(math.Asin(s)), true
+}
+
+// Finds Placidus houses.
+// Receives:
+//	asc: the Ascendant, as a unit.Angle
+//	mc: the Midheaven, as a unit.Angle
+//	lst: local sidereal time, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+//	φ: geocentric latitude, as a unit.Angle
+// Returns:
+//	cusps: all twelve cusps, house 1 first
+//	err: any errors encountered
+// Notes:
+//	Each intermediate cusp is the ecliptic point that has covered a third or two thirds of its own
+//	semi-arc. Its right ascension depends on its declination, so the cusp is found by iteration.
+func findPlacidus(asc, mc, lst, ε, φ unit.Angle) (cusps [12]unit.Angle, err error) {
+	// cusp offsets from RAMC: diurnal thirds for 11 and 12, nocturnal thirds for 2 and 3
+	cusp := func(diurnal bool, f float64) (unit.Angle, error) {
+		λ := findLongitudeFromRA(lst+unit.AngleFromDeg(90*f), ε)
+		for i := 0; i < 100; i++ {
+			AD, ok := findAscensionalDifference(λ, ε, φ)
+			if !ok {
+				return 0, errLatitude
+			}
+			var α unit.Angle
+			if diurnal {
+				α = lst + (angle90 + AD).Mul(f)
+			} else {
+				α = lst + angle180 - (angle90 - AD).Mul(f)
+			}
+			next := findLongitudeFromRA(α, ε)
+			done := math.Abs(math.Remainder((next - λ).Rad(), 2*math.Pi)) < 1e-10
+			λ = next
+			if done {
+				break
+			}
+		}
+		return λ, nil
+	}
+	var h [4]unit.Angle
+	for i, c := range []struct {
+		diurnal bool
+		f       float64
+	}{{true, 1. / 3}, {true, 2. / 3}, {false, 2. / 3}, {false, 1. / 3}} {
+		if h[i], err = cusp(c.diurnal, c.f); err != nil {
+			return
+		}
+	}
+	return quadrants(asc, mc, h[0], h[1], h[2], h[3]), nil
+}
+
+// Finds Koch houses.
+// Receives:
+//	asc: the Ascendant, as a unit.Angle
+//	mc: the Midheaven, as a unit.Angle
+//	lst: local sidereal time, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+//	φ: geocentric latitude, as a unit.Angle
+// Returns:
+//	cusps: all twelve cusps, house 1 first
+//	err: any errors encountered
+// Notes:
+//	Trisects the diurnal semi-arc of the Midheaven in oblique ascension. The thirds are counted from the
+//	Midheaven's own oblique ascension for houses 11 and 12, and from the Ascendant's for houses 2 and 3.
+//	The latitude is the pole for every cusp.
+func findKoch(asc, mc, lst, ε, φ unit.Angle) (cusps [12]unit.Angle, err error) {
+	AD, ok := findAscensionalDifference(mc, ε, φ)
+	if !ok {
+		return cusps, errLatitude
+	}
+	sf, cf := φ.Sincos()
+	oaMC := lst - AD
+	d := (angle90 + AD).Div(3)
+	h11 := findObliqueAscendant(oaMC+d, sf, cf, ε)
+	h12 := findObliqueAscendant(oaMC+d.Mul(2), sf, cf, ε)
+	h2 := findObliqueAscendant(lst+angle90+d, sf, cf, ε)
+	h3 := findObliqueAscendant(lst+angle90+d.Mul(2), sf, cf, ε)
+	return quadrants(asc, mc, h11, h12, h2, h3), nil
+}
+
+// Finds Campanus houses.
+// Receives:
+//	asc: the Ascendant, as a unit.Angle
+//	mc: the Midheaven, as a unit.Angle
+//	lst: local sidereal time, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+//	φ: geocentric latitude, as a unit.Angle
+// Returns:
+//	all twelve cusps, house 1 first
+// Notes:
+//	Divides the prime vertical into equal arcs. For a division H, the house circle meets the equator at
+//	RAMC + atan(tan H cos φ), and its pole satisfies sin f = sin φ sin H.
+func findCampanus(asc, mc, lst, ε, φ unit.Angle) [12]unit.Angle {
+	sφ, cφ := φ.Sincos()
+	cusp := func(H unit.Angle) unit.Angle {
+		sH, cH := H.Sincos()
+		oa := lst + unit.Angle(math.Atan2(sH*cφ, cH))
+		sf := sφ * sH
+		return findObliqueAscendant(oa, sf, math.Sqrt(1-sf*sf), ε)
+	}
+	return quadrants(asc, mc, cusp(angle30), cusp(angle30.Mul(2)), cusp(angle30.Mul(4)), cusp(angle30.Mul(5)))
+}
+
+// Finds Porphyry houses.
+// Receives:
+//	asc: the Ascendant, as a unit.Angle
+//	mc: the Midheaven, as a unit.Angle
+// Returns:
+//	all twelve cusps, house 1 first
+// Notes:
+//	Trisects the ecliptic arcs between the angles.
+func findPorphyry(asc, mc unit.Angle) [12]unit.Angle {
+	east := asc.Subtract(mc).Div(3)
+	west := angle180.Subtract(asc.Subtract(mc)).Div(3)
+	return quadrants(asc, mc, mc.Add(east), mc.Add(east.Mul(2)), asc.Add(west), asc.Add(west.Mul(2)))
+}
+
+// Finds houses of equal size starting from the first cusp.
+// Receives:
+//	h1: the first cusp, as a unit.Angle
+// Returns:
+//	all twelve cusps, house 1 first
+// Notes:
+//	Used for Equal houses from the Ascendant, and for Whole Sign houses from the start of the rising sign.
+func findEqual(h1 unit.Angle) (cusps [12]unit.Angle) {
+	for i := range cusps {
+		cusps[i] = h1.Add(angle30.Mul(float64(i)))
+	}
+	return
+}
+
+// Finds Alcabitius houses.
+// Receives:
+//	asc: the Ascendant, as a unit.Angle
+//	mc: the Midheaven, as a unit.Angle
+//	lst: local sidereal time, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+//	φ: geocentric latitude, as a unit.Angle
+// Returns:
+//	cusps: all twelve cusps, house 1 first
+//	err: any errors encountered
+// Notes:
+//	Trisects the semi-arcs of the Ascendant in right ascension, then projects the divisions onto the ecliptic
+//	along circles of declination.
+func findAlcabitius(asc, mc, lst, ε, φ unit.Angle) (cusps [12]unit.Angle, err error) {
+	AD, ok := findAscensionalDifference(asc, ε, φ)
+	if !ok {
+		return cusps, errLatitude
+	}
+	d := (angle90 + AD).Div(3)
+	n := (angle90 - AD).Div(3)
+	h11 := findLongitudeFromRA(lst+d, ε)
+	h12 := findLongitudeFromRA(lst+d.Mul(2), ε)
+	h2 := findLongitudeFromRA(lst+d.Mul(3)+n, ε)
+	h3 := findLongitudeFromRA(lst+d.Mul(3)+n.Mul(2), ε)
+	return quadrants(asc, mc, h11, h12, h2, h3), nil
+}
+
+// Finds Morinus houses.
+// Receives:
+//	lst: local sidereal time, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+// Returns:
+//	all twelve cusps, house 1 first
+// Notes:
+//	Divides the equator into twelve from the east point, then projects onto the ecliptic along circles of latitude.
+//	The first and tenth cusps are not the Ascendant and Midheaven.
+func findMorinus(lst, ε unit.Angle) (cusps [12]unit.Angle) {
+	cε := ε.Cos()
+	for i := range cusps {
+		sα, cα := (lst + angle90 + angle30.Mul(float64(i))).Sincos()
+		// (13.1) p. 93, for a point on the equator
+		cusps[i] = unit.Angle(math.Atan2(sα*cε, cα)).Mod1()
+	}
+	return
+}
+
+// Finds Meridian houses.
+// Receives:
+//	lst: local sidereal time, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+// Returns:
+//	all twelve cusps, house 1 first
+// Notes:
+//	Divides the equator into twelve from the meridian, then projects onto the ecliptic along circles of declination.
+//	The tenth cusp is the Midheaven; the first is the equatorial Ascendant, not the Ascendant.
+func findMeridian(lst, ε unit.Angle) (cusps [12]unit.Angle) {
+	for i := range cusps {
+		cusps[i] = findLongitudeFromRA(lst+angle90+angle30.Mul(float64(i)), ε)
+	}
+	return
+}
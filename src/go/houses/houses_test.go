@@ -0,0 +1,133 @@
+package houses_test
+
+import (
+	"math"
+	"testing"
+
+	houses "webeph/houses"
+	parallactic "webeph/parallactic"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+	williams "webeph/williams"
+)
+
+var (
+	ε = unit.AngleFromDeg(23.4392911)
+	// quadrant systems share the Ascendant and Midheaven
+	quadrantSystems = []int{houses.Regiomontanus, houses.Placidus, houses.Koch, houses.Campanus,
+		houses.Porphyry, houses.Alcabitius}
+)
+
+// Finds the difference between two longitudes, in degrees, in the range (-180, 180].
+func diff(a, b unit.Angle) float64 {
+	return math.Remainder(a.Deg()-b.Deg(), 360)
+}
+
+func TestAngles(t *testing.T) {
+	for _, φ := range []float64{-40, 0, 42, 60} {
+		for lst := 5.; lst < 360; lst += 25 {
+			θ := unit.AngleFromDeg(lst)
+			lat := unit.AngleFromDeg(φ)
+			asc := parallactic.FindAscendant(ε, lat, θ)
+			mc := williams.FindMediumCoeli(θ, ε)
+			for _, system := range quadrantSystems {
+				cusps, err := houses.Find(system, θ, ε, lat)
+				if err != nil {
+					t.Fatal(system, err)
+				}
+				if !testutils.CheckTolerance(diff(cusps[0], asc), 0, testutils.SecondTolerance) {
+					t.Errorf("system %d, φ %v, lst %v: cusp 1 %v, Ascendant %v", system, φ, lst, cusps[0].Deg(), asc.Deg())
+				}
+				if !testutils.CheckTolerance(diff(cusps[9], mc), 0, testutils.SecondTolerance) {
+					t.Errorf("system %d, φ %v, lst %v: cusp 10 %v, Midheaven %v", system, φ, lst, cusps[9].Deg(), mc.Deg())
+				}
+				for i := 0; i < 6; i++ {
+					if !testutils.CheckTolerance(math.Abs(diff(cusps[i+6], cusps[i])), 180, testutils.SecondTolerance) {
+						t.Errorf("system %d: cusps %d and %d are not opposite", system, i+1, i+7)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestEquator(t *testing.T) {
+	// At the equator every semi-arc is 90°, so the quadrant systems which
+	// divide space or time all coincide with Meridian houses.
+	for lst := 5.; lst < 360; lst += 25 {
+		θ := unit.AngleFromDeg(lst)
+		want, _ := houses.Find(houses.Meridian, θ, ε, 0)
+		for _, system := range []int{houses.Regiomontanus, houses.Placidus, houses.Koch, houses.Campanus, houses.Alcabitius} {
+			got, _ := houses.Find(system, θ, ε, 0)
+			for i := range got {
+				if !testutils.CheckTolerance(diff(got[i], want[i]), 0, testutils.SecondTolerance) {
+					t.Errorf("system %d, lst %v: cusp %d is %v, want %v", system, lst, i+1, got[i].Deg(), want[i].Deg())
+				}
+			}
+		}
+	}
+}
+
+func TestPlacidus(t *testing.T) {
+	// Each intermediate cusp has covered a third or two thirds of its semi-arc.
+	θ := unit.AngleFromDeg(130)
+	φ := unit.AngleFromDeg(42)
+	cusps, err := houses.Find(houses.Placidus, θ, ε, φ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gφ := williams.FindGeocentricLat(φ)
+	for _, c := range []struct {
+		house int
+		part  float64
+	}{{11, 1. / 3}, {12, 2. / 3}} {
+		λ := cusps[c.house-1]
+		sλ, cλ := λ.Sincos()
+		α := math.Atan2(sλ*ε.Cos(), cλ)
+		δ := math.Asin(ε.Sin() * sλ)
+		sda := math.Pi/2 + math.Asin(math.Tan(δ)*gφ.Tan())
+		H := math.Remainder(α-θ.Rad(), 2*math.Pi)
+		if !testutils.CheckTolerance(H, sda*c.part, 1e-9) {
+			t.Errorf("cusp %d: hour angle %v, want %v", c.house, H, sda*c.part)
+		}
+	}
+}
+
+func TestEqualWholeSign(t *testing.T) {
+	θ := unit.AngleFromDeg(200)
+	φ := unit.AngleFromDeg(35)
+	asc := parallactic.FindAscendant(ε, φ, θ)
+	equal, _ := houses.Find(houses.Equal, θ, ε, φ)
+	whole, _ := houses.Find(houses.WholeSign, θ, ε, φ)
+	if equal[0] != asc {
+		t.Errorf("Equal: cusp 1 %v, Ascendant %v", equal[0].Deg(), asc.Deg())
+	}
+	if math.Floor(whole[0].Deg()/30) != math.Floor(asc.Deg()/30) || math.Mod(whole[0].Deg(), 30) > 1e-9 {
+		t.Errorf("Whole Sign: cusp 1 %v, Ascendant %v", whole[0].Deg(), asc.Deg())
+	}
+	for i := 1; i < 12; i++ {
+		if !testutils.CheckTolerance(diff(equal[i], equal[i-1]), 30, 1e-9) {
+			t.Errorf("Equal: cusp %d is %v", i+1, equal[i].Deg())
+		}
+	}
+}
+
+func TestPolar(t *testing.T) {
+	// The Midheaven near 90° never sets at this latitude.
+	θ := unit.AngleFromDeg(90)
+	φ := unit.AngleFromDeg(75)
+	for _, system := range []int{houses.Placidus, houses.Koch} {
+		if _, err := houses.Find(system, θ, ε, φ); err == nil {
+			t.Errorf("system %d: expected error inside the polar circle", system)
+		}
+	}
+	if _, err := houses.Find(houses.Campanus, θ, ε, φ); err != nil {
+		t.Errorf("Campanus: %v", err)
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	if _, err := houses.Find(-1, 0, ε, 0); err == nil {
+		t.Error("expected error for invalid system")
+	}
+}
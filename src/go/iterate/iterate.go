@@ -0,0 +1,36 @@
+// Iterate: Chapter 5, Iteration.
+//
+// Only the binary search for a root is implemented.  It is the method used
+// by the searches in this library, which look for a sign change over a
+// bracketing interval.
+package iterate
+
+import "math"
+
+// BinaryRoot finds a root between given bounds by binary search.
+//
+// Inputs are a function on x and the bounds on x.  A root must exist between
+// the given bounds, otherwise the result is not meaningful.
+//
+// Argument tolerance is the width of the final bracket.  The search stops
+// when the bracket is narrower than tolerance, or when f is exactly zero.
+//
+// Result is root x.
+func BinaryRoot(f func(x float64) float64, lower, upper, tolerance float64) float64 {
+	yLower := f(lower)
+	mid := (lower + upper) / 2
+	for math.Abs(upper-lower) > tolerance {
+		mid = (lower + upper) / 2
+		yMid := f(mid)
+		if yMid == 0 {
+			return mid
+		}
+		if math.Signbit(yLower) == math.Signbit(yMid) {
+			lower = mid
+			yLower = yMid
+		} else {
+			upper = mid
+		}
+	}
+	return (lower + upper) / 2
+}
@@ -0,0 +1,27 @@
+package iterate_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	iterate "webeph/iterate"
+)
+
+func ExampleBinaryRoot() {
+	// the real root of x⁵ + 17x − 8
+	f := func(x float64) float64 {
+		return x*x*x*x*x + 17*x - 8
+	}
+	fmt.Printf("%.9f\n", iterate.BinaryRoot(f, 0, 1, 1e-12))
+	// Output:
+	// 0.469249878
+}
+
+func TestBinaryRootDecreasing(t *testing.T) {
+	// the sign of f at the lower bound does not matter
+	got := iterate.BinaryRoot(math.Cos, 0, 3, 1e-9)
+	if math.Abs(got-math.Pi/2) > 1e-9 {
+		t.Errorf("got %v, want %v", got, math.Pi/2)
+	}
+}
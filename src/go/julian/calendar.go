@@ -0,0 +1,72 @@
+package julian
+
+import (
+	"errors"
+	"math"
+)
+
+// Calendar reforms, as the Julian day at 0ʰ of the first Gregorian date.
+const (
+	ReformCatholic     = 2299160.5        // 1582 October 15 followed October 4: Italy, Spain, Portugal, Poland
+	ReformFrance       = 2299226.5        // 1582 December 20 followed December 9
+	ReformBritain      = 2361221.5        // 1752 September 14 followed September 2: Britain and its colonies
+	ReformSweden       = 2361389.5        // 1753 March 1 followed February 17
+	ReformRussia       = 2421638.5        // 1918 February 14 followed January 31
+	ProlepticGregorian = -math.MaxFloat64 // the Gregorian calendar for every date, before JD 0 too
+	ProlepticJulian    = math.MaxFloat64  // the Julian calendar for every date
+)
+
+var (
+	reform float64 = ReformCatholic
+)
+
+// Sets the calendar reform used by CalendarToJD and JDToCalendar.
+// Receives:
+//	jd: the Julian day at 0ʰ of the first Gregorian date. See the Reform constants.
+// Returns:
+//	nothing
+// Notes:
+//	Dates before the reform are in the Julian calendar, dates from it on in the Gregorian. The default is ReformCatholic.
+//export setCalendarReform
+func SetReform(jd float64) {
+	reform = jd
+}
+
+// Gets the calendar reform used by CalendarToJD and JDToCalendar.
+// Receives:
+//	nothing
+// Returns:
+//	the Julian day at 0ʰ of the first Gregorian date
+func Reform() float64 {
+	return reform
+}
+
+// Converts a year, month, and day of month to Julian day, in the calendar in force on that date.
+// Receives:
+//	y: the year
+//	m: the month, 1-12
+//	d: the day of month, with the time of day as a fraction
+// Returns:
+//	jd: the Julian day
+//	err: an error if the date was skipped by the reform
+// Notes:
+//	Uses CalendarJulianToJD before the reform set by SetReform, and CalendarGregorianToJD from it on.
+//	In Britain, 1752 September 3 through 13 never happened, so those dates are errors.
+func CalendarToJD(y, m int, d float64) (jd float64, err error) {
+	if jd = CalendarGregorianToJD(y, m, d); CalendarGregorianToJD(y, m, math.Floor(d)) >= reform {
+		return jd, nil
+	}
+	if jd = CalendarJulianToJD(y, m, d); CalendarJulianToJD(y, m, math.Floor(d)) < reform {
+		return jd, nil
+	}
+	return 0, errors.New("This date was skipped by the calendar reform.")
+}
+
+// Finds whether a Julian day falls in the Gregorian calendar.
+// Receives:
+//	jd: the Julian day
+// Returns:
+//	true from the reform set by SetReform on
+func IsGregorian(jd float64) bool {
+	return math.Floor(jd+.5)-.5 >= reform
+}
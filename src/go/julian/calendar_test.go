@@ -0,0 +1,69 @@
+package julian_test
+
+import (
+	"testing"
+
+	julian "webeph/julian"
+)
+
+func TestCalendarToJD(t *testing.T) {
+	defer julian.SetReform(julian.Reform())
+	for _, c := range []struct {
+		name   string
+		reform float64
+		y, m   int
+		d      float64
+		jd     float64
+	}{
+		{"last Julian date, Catholic", julian.ReformCatholic, 1582, 10, 4, 2299159.5},
+		{"first Gregorian date, Catholic", julian.ReformCatholic, 1582, 10, 15, 2299160.5},
+		{"Example 7.b", julian.ReformCatholic, 333, 1, 27.5, 1842713},
+		{"last Julian date, Britain", julian.ReformBritain, 1752, 9, 2, 2361220.5},
+		{"first Gregorian date, Britain", julian.ReformBritain, 1752, 9, 14, 2361221.5},
+		// Newton was born on Christmas Day 1642 in England, 1643 January 4 on the continent.
+		{"Newton, Britain", julian.ReformBritain, 1642, 12, 25, 2321156.5},
+		{"Newton, Catholic", julian.ReformCatholic, 1643, 1, 4, 2321156.5},
+		{"Sputnik, proleptic Julian", julian.ProlepticJulian, 1957, 9, 21.81, 2436116.31},
+		{"Example 7.a, proleptic Gregorian", julian.ProlepticGregorian, 1957, 10, 4.81, 2436116.31},
+		{"before JD 0, proleptic Gregorian", julian.ProlepticGregorian, -4713, 11, 24, -.5},
+	} {
+		julian.SetReform(c.reform)
+		jd, err := julian.CalendarToJD(c.y, c.m, c.d)
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if jd-c.jd > 1e-6 || c.jd-jd > 1e-6 {
+			t.Errorf("%s: JD %v, want %v", c.name, jd, c.jd)
+		}
+	}
+}
+
+func TestCalendarToJDSkipped(t *testing.T) {
+	defer julian.SetReform(julian.Reform())
+	julian.SetReform(julian.ReformBritain)
+	if _, err := julian.CalendarToJD(1752, 9, 5); err == nil {
+		t.Error("1752 September 5 never happened in Britain")
+	}
+	julian.SetReform(julian.ReformCatholic)
+	if _, err := julian.CalendarToJD(1752, 9, 5); err != nil {
+		t.Errorf("1752 September 5 in Catholic countries: %v", err)
+	}
+}
+
+func TestJDToCalendarReform(t *testing.T) {
+	defer julian.SetReform(julian.Reform())
+	for _, c := range []struct {
+		reform  float64
+		y, m, d int
+	}{
+		{julian.ReformCatholic, 1643, 1, 4},
+		{julian.ReformBritain, 1642, 12, 25},
+	} {
+		julian.SetReform(c.reform)
+		date := julian.JDToCalendar(2321156.5)
+		if date.Year != c.y || date.Month != c.m || date.Day != c.d {
+			t.Errorf("reform %v: %d-%d-%d, want %d-%d-%d", c.reform, date.Year, date.Month, date.Day, c.y, c.m, c.d)
+		}
+	}
+}
@@ -54,7 +54,24 @@ func CalendarJulianToJD(y, m int, d float64) float64 {
 		float64(base.FloorDiv(306*(m+1), 10)) + d - 1524.5
 }
 
-// Returns the container for calendar date values calculated by JDToCalendar.
+// Calendar constants.
+const (
+	Julian = iota
+	Gregorian
+)
+
+// Date is a calendar date and time.
+type Date struct {
+	Year     int
+	Month    int // 1-12
+	Day      int
+	Hour     int
+	Minute   int
+	Second   float64
+	Calendar int // Julian or Gregorian
+}
+
+// Returns the container for calendar date values calculated by FindTimeComponents.
 // Receives:
 //	nothing
 // Returns:
@@ -68,15 +85,22 @@ func GetTimeContainer() *[6]int {
 // Receives:
 //	jd: a Julian day, as a float64
 // Returns:
-//	nothing
+//	the date, with seconds to the microsecond
 // Notes:
-//	Stores results in a package-level container. Use GetTimeContainer to retrieve results.
-//export jdToCalendar
-func JDToCalendar(jd float64) {
+//	The date is in the Julian calendar before the reform set by SetReform, and in the Gregorian from it on.
+//	Rounding to the microsecond carries into the minute, hour and day, so seconds never reach 60.
+func JDToCalendar(jd float64) (date Date) {
 	zf, f := math.Modf(jd + .5)
 	z := int64(zf)
+	μs := int64(math.Round(f * 86400e6))
+	if μs >= 86400e6 {
+		z++
+		μs -= 86400e6
+	}
 	a := z
-	if z >= 2299151 {
+	date.Calendar = Julian
+	if float64(z)-.5 >= reform {
+		date.Calendar = Gregorian
 		α := base.FloorDiv64(z*100-186721625, 3652425)
 		a = z + 1 + α - base.FloorDiv64(α, 4)
 	}
@@ -85,25 +109,37 @@ func JDToCalendar(jd float64) {
 	d := base.FloorDiv64(36525*c, 100)
 	e := int(base.FloorDiv64((b-d)*1e4, 306001))
 	// compute return values
-	var year, month, day int
 	switch e {
 	default:
-		month = e - 1
+		date.Month = e - 1
 	case 14, 15:
-		month = e - 13
+		date.Month = e - 13
 	}
-	switch month {
+	switch date.Month {
 	default:
-		year = int(c) - 4716
+		date.Year = int(c) - 4716
 	case 1, 2:
-		year = int(c) - 4715
+		date.Year = int(c) - 4715
 	}
-	floatDay := float64(int(b-d)-base.FloorDiv(306001*e, 1e4)) + f
-	day = int(math.Floor(floatDay))
-	floatHr := (floatDay - float64(day)) * 24
-	floatMn := (floatHr - math.Floor(floatHr)) * 60
-	floatSc := math.Round((floatMn - math.Floor(floatMn)) * 60)
-	// Months produced by this algorithm fall between 1 and 12.
-	// Moment.js needs them to fall between 0 and 11.
-	timeComponents = [6]int{year, month - 1, day, int(floatHr), int(floatMn), int(floatSc)}
+	date.Day = int(b-d) - base.FloorDiv(306001*e, 1e4)
+	date.Hour = int(μs / 3600e6)
+	date.Minute = int(μs / 60e6 % 60)
+	date.Second = float64(μs%60e6) / 1e6
+	return
+}
+
+// Finds the calendar date for a given Julian day, for Javascript.
+// Receives:
+//	jd: a Julian day, as a float64
+// Returns:
+//	nothing
+// Notes:
+//	Stores results in a package-level container. Use GetTimeContainer to retrieve results.
+//	Months run from 0 to 11 and seconds are whole, as Moment.js expects. Seconds are rounded, not truncated:
+//	a Julian day holds a time only to some tens of microseconds, so an exact second can come back a hair short.
+//	The rounding carries into the minute, hour and day.
+//export jdToCalendar
+func FindTimeComponents(jd float64) {
+	date := JDToCalendar(jd + .5/86400)
+	timeComponents = [6]int{date.Year, date.Month - 1, date.Day, date.Hour, date.Minute, int(date.Second)}
 }
@@ -59,13 +59,10 @@ func ExampleCalendarJulianToJD() {
 
 func ExampleJDToCalendar() {
 	// Example 7.c, p. 64.
-	cmpnts := julian.GetTimeContainer()
-	julian.JDToCalendar(2436116.31)
-	y, m, d, hr, mn, sc := cmpnts[0], cmpnts[1], cmpnts[2], cmpnts[3], cmpnts[4], cmpnts[5]
-	m += 1 // Convert from Moment format to TinyGo format
-	fmt.Printf("%d %s %d %d %d %d\n", y, time.Month(m), d, hr, mn, sc)
+	date := julian.JDToCalendar(2436116.31)
+	fmt.Printf("%d %s %d %d %d %.3f\n", date.Year, time.Month(date.Month), date.Day, date.Hour, date.Minute, date.Second)
 	// Output:
-	// 1957 October 4 19 26 24
+	// 1957 October 4 19 26 24.000
 }
 
 func TestYMD(t *testing.T) {
@@ -76,13 +73,37 @@ func TestYMD(t *testing.T) {
 		{1842713, 333, 1, 27, 12, 0, 0},
 		{1507900.13, -584, 5, 28, 15, 7, 12},
 	} {
-		cmpnts := julian.GetTimeContainer()
-		julian.JDToCalendar(tp.jd)
-		y, m, d, hr, mn, sc := cmpnts[0], cmpnts[1], cmpnts[2], cmpnts[3], cmpnts[4], cmpnts[5]
-		m += 1 // Convert from Moment format to TinyGo format
-		if y != tp.y || m != tp.m || d != tp.d || hr != tp.hr || mn != tp.mn || sc != tp.sc {
+		date := julian.JDToCalendar(tp.jd)
+		y, m, d, hr, mn, sc := date.Year, date.Month, date.Day, date.Hour, date.Minute, int(math.Round(date.Second))
+		if y != tp.y || m != tp.m || d != tp.d || hr != tp.hr || mn != tp.mn || sc != tp.sc || date.Calendar != julian.Julian {
 			t.Logf("%#v", tp)
 			t.Fatal("JDToYMD", y, m, d, hr, mn, sc)
 		}
 	}
 }
+
+func TestJDToCalendarCarry(t *testing.T) {
+	// A hair before midnight rounds up to the next day, not to 23:59:60.
+	date := julian.JDToCalendar(2459580.5 - 1e-11)
+	if date != (julian.Date{Year: 2022, Month: 1, Day: 1, Calendar: julian.Gregorian}) {
+		t.Errorf("%+v, want 2022 January 1 at midnight", date)
+	}
+	// Fractions of a second survive.
+	date = julian.JDToCalendar(julian.CalendarGregorianToJD(2022, 1, 1+(12+34./60+56.789/3600)/24))
+	if date.Hour != 12 || date.Minute != 34 || math.Abs(date.Second-56.789) > 1e-4 {
+		t.Errorf("%+v, want 12:34:56.789", date)
+	}
+}
+
+func TestFindTimeComponents(t *testing.T) {
+	// 12:34:01 on the dot comes back from the Julian day as 12:34:00.999994.
+	julian.FindTimeComponents(julian.CalendarGregorianToJD(2022, 1, 1+(12+34./60+1./3600)/24))
+	if got, want := *julian.GetTimeContainer(), [6]int{2022, 0, 1, 12, 34, 1}; got != want {
+		t.Errorf("%v, want %v", got, want)
+	}
+	// Half a second before midnight rounds into the next year.
+	julian.FindTimeComponents(julian.CalendarGregorianToJD(2022, 12, 31+(23+59./60+59.5/3600)/24))
+	if got, want := *julian.GetTimeContainer(), [6]int{2023, 0, 1, 0, 0, 0}; got != want {
+		t.Errorf("%v, want %v", got, want)
+	}
+}
@@ -0,0 +1,103 @@
+package julian
+
+import (
+	"errors"
+	"time"
+	// Embeds the IANA time zone database, so zones load in WASM without a file system.
+	_ "time/tzdata"
+)
+
+// Policies for local times that a clock change skips or repeats.
+const (
+	Earlier = iota // in a fold, the first occurrence; in a gap, the offset in force before the change
+	Later          // in a fold, the second occurrence; in a gap, the offset in force after the change
+	Strict         // an error in a gap or a fold
+)
+
+// Status of a local time.
+const (
+	Valid = iota // the local time happens exactly once
+	Gap          // the local time is skipped by a clock change, as when daylight saving time begins
+	Fold         // the local time happens twice, as when daylight saving time ends
+)
+
+// Julian day of 1970 January 1, 0ʰ UT.
+const unixEpoch = 2440587.5
+
+// Converts a local civil date and time in an IANA time zone to Julian day.
+// Receives:
+//	y, m, d: the Gregorian year, month (1-12) and day of month
+//	h, min: the hour and minute on the local clock
+//	s: the seconds
+//	zone: the IANA zone name, such as "America/New_York"
+//	policy: Earlier, Later or Strict, for times in a gap or a fold
+// Returns:
+//	jd: the Julian day, in UT
+//	status: Valid, Gap or Fold
+//	err: any errors encountered
+// Notes:
+//	The zone rules come from the embedded IANA database, including local mean time before standard zones.
+//	In a gap, Earlier reads the clock with the offset in force before the change: 2:30 on the morning daylight time begins
+//	in New York is read as 2:30 EST, which is 3:30 EDT. Later reads it as 2:30 EDT, which is 1:30 EST.
+//	In a fold, Earlier gives the first of the two moments showing that time, and Later the second.
+//	Status is reported whatever the policy, so that a user interface can ask which moment was meant.
+func CalendarZonedToJD(y, m, d, h, min int, s float64, zone string, policy int) (jd float64, status int, err error) {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return 0, Valid, err
+	}
+	// the clock reading, counted as if it were UT
+	wall := time.Date(y, time.Month(m), d, h, min, 0, 0, time.UTC).Unix()
+	// The offsets a day before and after cover any clock change affecting this reading.
+	before := zoneOffset(loc, wall-86400)
+	after := zoneOffset(loc, wall+86400)
+	var instants []int64
+	for _, offset := range []int64{before, after} {
+		if instant := wall - offset; zoneOffset(loc, instant) == offset {
+			if len(instants) == 0 || instants[0] != instant {
+				instants = append(instants, instant)
+			}
+		}
+	}
+	var instant int64
+	switch len(instants) {
+	case 1:
+		instant = instants[0]
+	case 0:
+		status = Gap
+		switch policy {
+		case Earlier:
+			instant = wall - before
+		case Later:
+			instant = wall - after
+		default:
+			return 0, status, errors.New("This local time is skipped by a clock change.")
+		}
+	default:
+		status = Fold
+		first, second := instants[0], instants[1]
+		if second < first {
+			first, second = second, first
+		}
+		switch policy {
+		case Earlier:
+			instant = first
+		case Later:
+			instant = second
+		default:
+			return 0, status, errors.New("This local time happens twice because of a clock change.")
+		}
+	}
+	return unixEpoch + (float64(instant)+s)/86400, status, nil
+}
+
+// Finds the offset of a zone from UT at a moment.
+// Receives:
+//	loc: the zone
+//	unix: the moment, in seconds since 1970 January 1, 0ʰ UT
+// Returns:
+//	the offset east of Greenwich, in seconds
+func zoneOffset(loc *time.Location, unix int64) int64 {
+	_, offset := time.Unix(unix, 0).In(loc).Zone()
+	return int64(offset)
+}
@@ -0,0 +1,54 @@
+package julian_test
+
+import (
+	"testing"
+
+	julian "webeph/julian"
+	testutils "webeph/testutils"
+)
+
+func TestCalendarZonedToJD(t *testing.T) {
+	second := 1. / 86400
+	for _, c := range []struct {
+		name            string
+		y, m, d, h, min int
+		policy          int
+		status          int
+		wantY, wantM    int
+		wantD           float64
+	}{
+		// 2022 July 1, 12:00 EDT is 16:00 UT.
+		{"summer", 2022, 7, 1, 12, 0, julian.Strict, julian.Valid, 2022, 7, 1 + 16./24},
+		// Daylight time began at 2:00 EST on 2022 March 13; 2:30 never showed on New York clocks.
+		{"gap earlier", 2022, 3, 13, 2, 30, julian.Earlier, julian.Gap, 2022, 3, 13 + 7.5/24},
+		{"gap later", 2022, 3, 13, 2, 30, julian.Later, julian.Gap, 2022, 3, 13 + 6.5/24},
+		// Daylight time ended at 2:00 EDT on 2022 November 6; 1:30 showed twice.
+		{"fold earlier", 2022, 11, 6, 1, 30, julian.Earlier, julian.Fold, 2022, 11, 6 + 5.5/24},
+		{"fold later", 2022, 11, 6, 1, 30, julian.Later, julian.Fold, 2022, 11, 6 + 6.5/24},
+		// Before 1883 New York kept local mean time, 4ʰ56ᵐ02ˢ behind Greenwich.
+		{"local mean time", 1850, 1, 1, 12, 0, julian.Strict, julian.Valid, 1850, 1, 1 + (16+56./60+2./3600)/24},
+	} {
+		jd, status, err := julian.CalendarZonedToJD(c.y, c.m, c.d, c.h, c.min, 0, "America/New_York", c.policy)
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if status != c.status {
+			t.Errorf("%s: status %d, want %d", c.name, status, c.status)
+		}
+		if want := julian.CalendarGregorianToJD(c.wantY, c.wantM, c.wantD); !testutils.CheckTolerance(jd, want, second) {
+			t.Errorf("%s: JD %v, want %v", c.name, jd, want)
+		}
+	}
+}
+
+func TestCalendarZonedToJDStrict(t *testing.T) {
+	for _, c := range [][3]int{{3, 13, 2}, {11, 6, 1}} {
+		if _, _, err := julian.CalendarZonedToJD(2022, c[0], c[1], c[2], 30, 0, "America/New_York", julian.Strict); err == nil {
+			t.Errorf("2022-%02d-%02d %02d:30: no error", c[0], c[1], c[2])
+		}
+	}
+	if _, _, err := julian.CalendarZonedToJD(2022, 1, 1, 0, 0, 0, "Nowhere/Atlantis", julian.Earlier); err == nil {
+		t.Error("unknown zone: no error")
+	}
+}
@@ -0,0 +1,160 @@
+// Lots: the Arabic parts, points found as A + B − C along the ecliptic.
+//
+// Most lots run from one point to another and cast that distance from the
+// Ascendant.  By night the distance runs the other way, so B and C trade
+// places.  A chart is a night chart when the Sun is below the horizon, in
+// houses 1 through 6.
+package lots
+
+import (
+	"errors"
+
+	houses "webeph/houses"
+	parallactic "webeph/parallactic"
+	pp "webeph/planetposition"
+	unit "webeph/unit"
+)
+
+// Point constants, beyond the planetposition constants for the planets. Each lot is also a point,
+// so one lot can be cast from another.
+const (
+	Ascendant = pp.Pluto + 1 + iota
+	Fortune
+	Spirit
+	Eros
+	Necessity
+	Marriage
+	nPoints
+)
+
+// Formula is a lot: A + B − C.
+type Formula struct {
+	A, B, C int  // points: planetposition constants, Ascendant, or lots
+	Reverse bool // true to trade B and C in a night chart
+}
+
+// Standard holds the formulas of the lots named by the point constants, after Paulus Alexandrinus and Dorotheus.
+var Standard = map[int]Formula{
+	Fortune:   {Ascendant, pp.Moon, pp.Sun, true},
+	Spirit:    {Ascendant, pp.Sun, pp.Moon, true},
+	Eros:      {Ascendant, pp.Venus, Spirit, true},
+	Necessity: {Ascendant, Fortune, pp.Mercury, true},
+	Marriage:  {Ascendant, pp.Venus, pp.Saturn, true},
+}
+
+// Chart holds the points lots are cast from.
+type Chart struct {
+	Planets   [pp.Pluto + 1]unit.Angle // ecliptic longitudes, indexed by the planetposition constants
+	Ascendant unit.Angle               // ecliptic longitude of the Ascendant
+	Diurnal   bool                     // true when the Sun is above the horizon
+}
+
+// Builds a chart from planet longitudes and the time and place.
+// Receives:
+//	planets: tropical ecliptic longitudes, indexed by the planetposition constants
+//	lst: local sidereal time, as a unit.Angle
+//	ε: obliquity, as a unit.Angle
+//	φ: geographic latitude, as a unit.Angle
+// Returns:
+//	c: the chart
+//	err: any errors encountered
+// Notes:
+//	The Ascendant comes from parallactic.FindAscendant. The sect comes from the house of the Sun,
+//	in Regiomontanus houses built on williams.FindHouses: houses 7 through 12 lie above the horizon.
+//	The Ascendant is tropical: for a sidereal chart, pass tropical planets and convert the lots found with web.ToZodiac.
+func NewChart(planets [pp.Pluto + 1]unit.Angle, lst, ε, φ unit.Angle) (c Chart, err error) {
+	cusps, err := houses.Find(houses.Regiomontanus, lst, ε, φ)
+	if err != nil {
+		return
+	}
+	c.Planets = planets
+	c.Ascendant = parallactic.FindAscendant(ε, φ, lst)
+	c.Diurnal = House(planets[pp.Sun], cusps) >= 7
+	return
+}
+
+// Finds the house a longitude falls in.
+// Receives:
+//	λ: ecliptic longitude, as a unit.Angle
+//	cusps: the twelve house cusps, house 1 first
+// Returns:
+//	the house, from 1 to 12
+func House(λ unit.Angle, cusps [12]unit.Angle) int {
+	for i := range cusps {
+		next := cusps[(i+1)%12]
+		span := (next - cusps[i]).Mod1()
+		if (λ - cusps[i]).Mod1() < span {
+			return i + 1
+		}
+	}
+	return 12
+}
+
+// Casts a lot.
+// Receives:
+//	f: the formula
+// Returns:
+//	λ: the ecliptic longitude of the lot, as a unit.Angle
+//	err: an error for an unknown point
+// Notes:
+//	A lot named in a formula is first cast from Standard.
+func (c *Chart) Find(f Formula) (λ unit.Angle, err error) {
+	b, cc := f.B, f.C
+	if f.Reverse && !c.Diurnal {
+		b, cc = cc, b
+	}
+	var p [3]unit.Angle
+	for i, point := range [3]int{f.A, b, cc} {
+		if p[i], err = c.point(point); err != nil {
+			return
+		}
+	}
+	return (p[0] + p[1] - p[2]).Mod1(), nil
+}
+
+// Casts a set of lots.
+// Receives:
+//	formulas: the formulas
+// Returns:
+//	lots: the ecliptic longitudes of the lots, in the order of the formulas
+//	err: an error for an unknown point
+func (c *Chart) FindAll(formulas []Formula) (lots []unit.Angle, err error) {
+	lots = make([]unit.Angle, len(formulas))
+	for i, f := range formulas {
+		if lots[i], err = c.Find(f); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// Casts the standard lots.
+// Receives:
+//	nothing
+// Returns:
+//	lots: the longitudes of Fortune, Spirit, Eros, Necessity and Marriage, in that order
+func (c *Chart) FindStandard() (lots [nPoints - Fortune]unit.Angle) {
+	for i := range lots {
+		// the standard formulas name only known points
+		lots[i], _ = c.Find(Standard[Fortune+i])
+	}
+	return
+}
+
+// Finds the longitude of a point.
+// Receives:
+//	point: a planetposition constant, Ascendant, or a lot
+// Returns:
+//	the ecliptic longitude, as a unit.Angle
+//	err: an error for an unknown point
+func (c *Chart) point(point int) (unit.Angle, error) {
+	switch f, ok := Standard[point]; {
+	case point == pp.Earth || point < 0 || point >= nPoints:
+		return 0, errors.New("Invalid point.")
+	case point == Ascendant:
+		return c.Ascendant, nil
+	case ok:
+		return c.Find(f)
+	}
+	return c.Planets[point], nil
+}
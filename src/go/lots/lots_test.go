@@ -0,0 +1,117 @@
+package lots_test
+
+import (
+	"testing"
+
+	julian "webeph/julian"
+	lots "webeph/lots"
+	pp "webeph/planetposition"
+	pluto "webeph/pluto"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+	web "webeph/web"
+)
+
+var ε = unit.AngleFromDeg(23.44)
+
+// At the equator with the first point of Aries culminating, the Ascendant is 0° Cancer.
+func newChart(t *testing.T, sun, moon unit.Angle) lots.Chart {
+	var planets [pp.Pluto + 1]unit.Angle
+	planets[pp.Sun] = sun
+	planets[pp.Moon] = moon
+	planets[pp.Mercury] = unit.AngleFromDeg(350)
+	planets[pp.Venus] = unit.AngleFromDeg(40)
+	planets[pp.Saturn] = unit.AngleFromDeg(300)
+	c, err := lots.NewChart(planets, 0, ε, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !testutils.CheckTolerance(c.Ascendant.Deg(), 90, testutils.SecondTolerance) {
+		t.Fatalf("Ascendant %v, want 90", c.Ascendant.Deg())
+	}
+	return c
+}
+
+func checkLots(t *testing.T, got [5]unit.Angle, want [5]float64) {
+	names := []string{"Fortune", "Spirit", "Eros", "Necessity", "Marriage"}
+	for i, w := range want {
+		if !testutils.CheckTolerance(got[i].Deg(), w, testutils.SecondTolerance) {
+			t.Errorf("%s: %v, want %v", names[i], got[i].Deg(), w)
+		}
+	}
+}
+
+func TestDay(t *testing.T) {
+	// The Sun culminates, in house 10.
+	c := newChart(t, unit.AngleFromDeg(10), unit.AngleFromDeg(70))
+	if !c.Diurnal {
+		t.Fatal("want a day chart")
+	}
+	// Fortune 90 + 70 − 10, Spirit 90 + 10 − 70, Eros 90 + 40 − Spirit,
+	// Necessity 90 + Fortune − 350, Marriage 90 + 40 − 300.
+	checkLots(t, c.FindStandard(), [5]float64{150, 30, 100, 250, 190})
+}
+
+func TestNight(t *testing.T) {
+	// The Sun is at the lower meridian, in house 4.
+	c := newChart(t, unit.AngleFromDeg(190), unit.AngleFromDeg(70))
+	if c.Diurnal {
+		t.Fatal("want a night chart")
+	}
+	// Fortune 90 + 190 − 70, Spirit 90 + 70 − 190, Eros 90 + Spirit − 40,
+	// Necessity 90 + 350 − Fortune, Marriage 90 + 300 − 40.
+	checkLots(t, c.FindStandard(), [5]float64{210, 330, 20, 230, 350})
+}
+
+func TestUserDefined(t *testing.T) {
+	c := newChart(t, unit.AngleFromDeg(190), unit.AngleFromDeg(70))
+	found, err := c.FindAll([]lots.Formula{
+		// the Lot of Saturn, from Fortune to Saturn by day and reversed by night
+		{A: lots.Ascendant, B: pp.Saturn, C: lots.Fortune, Reverse: true},
+		// a lot that does not reverse
+		{A: lots.Ascendant, B: pp.Venus, C: pp.Saturn},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []float64{90 + 210 - 300 + 360, 90 + 40 - 300 + 360} {
+		if !testutils.CheckTolerance(found[i].Deg(), want, testutils.SecondTolerance) {
+			t.Errorf("lot %d: %v, want %v", i, found[i].Deg(), want)
+		}
+	}
+	if _, err := c.Find(lots.Formula{A: lots.Ascendant, B: pp.Earth, C: pp.Sun}); err == nil {
+		t.Error("the Earth is not a point of the chart")
+	}
+}
+
+func TestPlutoOutOfRange(t *testing.T) {
+	// Pluto is known only from 1885 to 2099: a lot using it is an error outside that range, and the standard lots are not.
+	jd := julian.CalendarGregorianToJD(1850, 1, 1)
+	site := &web.Site{Lat: unit.AngleFromDeg(51.5074), Lon: unit.AngleFromDeg(-.1278)}
+	if _, _, err := web.FindLots(jd, site); err != nil {
+		t.Errorf("standard lots: %v", err)
+	}
+	formula := lots.Formula{A: lots.Ascendant, B: pp.Pluto, C: pp.Sun}
+	if _, _, err := web.FindCustomLots(jd, site, []lots.Formula{formula}); err != pluto.ErrRange {
+		t.Errorf("in 1850: %v, want %v", err, pluto.ErrRange)
+	}
+	if _, _, err := web.FindCustomLots(julian.CalendarGregorianToJD(2000, 1, 1), site, []lots.Formula{formula}); err != nil {
+		t.Errorf("in 2000: %v", err)
+	}
+}
+
+func TestHouse(t *testing.T) {
+	var cusps [12]unit.Angle
+	for i := range cusps {
+		// house 9 runs up to 360°, and house 10 begins at 0°
+		cusps[i] = unit.AngleFromDeg(float64(90+30*i) - 360*float64((90+30*i)/360))
+	}
+	for _, c := range []struct {
+		λ    float64
+		want int
+	}{{90, 1}, {119, 1}, {120, 2}, {359, 9}, {0, 10}, {30, 11}, {89, 12}} {
+		if got := lots.House(unit.AngleFromDeg(c.λ), cusps); got != c.want {
+			t.Errorf("%v°: house %d, want %d", c.λ, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Moonphase: Chapter 49, Phases of the Moon.
+//
+// Instead of the periodic terms of chapter 49, the mean phase (49.1) only
+// brackets each event, which is then found by a root search on the
+// elongation of moonposition.Position from solar.ApparentLongitude.
+package moonphase
+
+import (
+	"math"
+
+	base "webeph/base"
+	deltat "webeph/deltat"
+	iterate "webeph/iterate"
+	moonposition "webeph/moonposition"
+	nutation "webeph/nutation"
+	solar "webeph/solar"
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+// Phase constants. Each is the elongation of the Moon, in quarters of a circle.
+const (
+	New = iota
+	FirstQuarter
+	Full
+	LastQuarter
+)
+
+// Event is an exact phase of the Moon.
+type Event struct {
+	JD       float64 // Julian day, in UT
+	Phase    int     // one of the phase constants
+	Lunation int     // Brown lunation number: lunation 1 began with the new moon of 1923 January 17
+}
+
+const (
+	// Meeus counts lunations from the new moon of 2000 January 6; Brown from that of 1923 January 17.
+	brownOffset = 953
+	// Half width of the bracket around the mean phase, in days. True phases differ from mean ones by under a day.
+	bracket = 1.5
+	// Width of the final bracket, in days: about a second.
+	tolerance = 1. / 86400
+)
+
+// Mean returns the Julian ephemeris day of a mean phase.
+//
+// Argument k is the lunation counted from the new moon of 2000 January 6,
+// plus .25, .5 or .75 for the quarters.
+func Mean(k float64) float64 {
+	T := k / 1236.85
+	// (49.1) p. 349
+	return base.Horner(T, 2451550.09766+29.530588861*k,
+		0, .00015437, -.00000015, .00000000073)
+}
+
+// Elongation returns the geocentric elongation of the Moon from the Sun.
+//
+// Argument jde is the Julian ephemeris day.  The result is the apparent
+// longitude of the Moon minus that of the Sun, in the range 0..2π.
+func Elongation(jde float64) unit.Angle {
+	λ, _, _ := moonposition.Position(jde)
+	Δψ, _ := nutation.Nutation(jde)
+	return (λ + Δψ - solar.ApparentLongitude(base.J2000Century(jde))).Mod1()
+}
+
+// Finds every phase of the Moon between two dates.
+// Receives:
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+// Returns:
+//	events: the phases found, in order of time
+// Notes:
+//	Events are exact to the accuracy of solar.ApparentLongitude, about a minute of time.
+func Find(jdStart, jdEnd float64) (events []Event) {
+	jdeStart := deltat.JDE(jdStart)
+	jdeEnd := deltat.JDE(jdEnd)
+	// start one quarter early; the bracket may reach back past the mean phase
+	q := int(math.Floor((jdeStart-2451550.09766)/29.530588861*4)) - 1
+	for ; Mean(float64(q)/4)-bracket < jdeEnd; q++ {
+		k := float64(q) / 4
+		phase := ((q % 4) + 4) % 4
+		target := unit.AngleFromDeg(float64(90 * phase))
+		mean := Mean(k)
+		jde := iterate.BinaryRoot(func(jde float64) float64 {
+			return zabinski.FindSignedDiff(Elongation(jde), target)
+		}, mean-bracket, mean+bracket, tolerance)
+		jd := deltat.JD(jde)
+		if jd < jdStart || jd >= jdEnd {
+			continue
+		}
+		events = append(events, Event{
+			JD:       jd,
+			Phase:    phase,
+			Lunation: int(math.Floor(k)) + brownOffset,
+		})
+	}
+	return
+}
@@ -0,0 +1,57 @@
+package moonphase_test
+
+import (
+	"fmt"
+	"testing"
+
+	deltat "webeph/deltat"
+	julian "webeph/julian"
+	moonphase "webeph/moonphase"
+	testutils "webeph/testutils"
+)
+
+func ExampleMean() {
+	// Example 49.a, p. 353.
+	fmt.Printf("%.5f\n", moonphase.Mean(-283))
+	// Output:
+	// 2443192.94102
+}
+
+func TestExample49a(t *testing.T) {
+	// Example 49.a, p. 353: new moon of 1977 February 18, at 3ʰ37ᵐ40ˢ TD.
+	jde := 2443192.65118
+	found := moonphase.Find(deltat.JD(jde)-1, deltat.JD(jde)+1)
+	if len(found) != 1 || found[0].Phase != moonphase.New {
+		t.Fatalf("found %+v, want one new moon", found)
+	}
+	if !testutils.CheckTolerance(deltat.JDE(found[0].JD), jde, 2*testutils.JulianMinuteTolerance) {
+		t.Errorf("JDE %v, want %v", deltat.JDE(found[0].JD), jde)
+	}
+	if found[0].Lunation != -283+953 {
+		t.Errorf("lunation %d, want %d", found[0].Lunation, -283+953)
+	}
+}
+
+func TestJanuary2022(t *testing.T) {
+	// Published phases, in UT. Lunation 1225 began on 2022 January 2.
+	published := []moonphase.Event{
+		{JD: julian.CalendarGregorianToJD(2022, 1, 2+(18+33./60)/24), Phase: moonphase.New, Lunation: 1225},
+		{JD: julian.CalendarGregorianToJD(2022, 1, 9+(18+11./60)/24), Phase: moonphase.FirstQuarter, Lunation: 1225},
+		{JD: julian.CalendarGregorianToJD(2022, 1, 17+(23+48./60)/24), Phase: moonphase.Full, Lunation: 1225},
+		{JD: julian.CalendarGregorianToJD(2022, 1, 25+(13+41./60)/24), Phase: moonphase.LastQuarter, Lunation: 1225},
+		{JD: julian.CalendarGregorianToJD(2022, 2, 1+(5+46./60)/24), Phase: moonphase.New, Lunation: 1226},
+	}
+	found := moonphase.Find(julian.CalendarGregorianToJD(2022, 1, 1), julian.CalendarGregorianToJD(2022, 2, 2))
+	if len(found) != len(published) {
+		t.Fatalf("found %d phases, want %d", len(found), len(published))
+	}
+	for i, p := range published {
+		f := found[i]
+		if f.Phase != p.Phase || f.Lunation != p.Lunation {
+			t.Errorf("event %d: phase %d lunation %d, want phase %d lunation %d", i, f.Phase, f.Lunation, p.Phase, p.Lunation)
+		}
+		if !testutils.CheckTolerance(f.JD, p.JD, 2*testutils.JulianMinuteTolerance) {
+			t.Errorf("event %d: JD %v, want %v", i, f.JD, p.JD)
+		}
+	}
+}
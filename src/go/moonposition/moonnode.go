@@ -3,6 +3,7 @@ package moonposition
 import (
 	"math"
 	base "webeph/base"
+	deltat "webeph/deltat"
 	unit "webeph/unit"
 )
 
@@ -170,7 +171,7 @@ func Node(jde float64) unit.Angle {
 
 // Finds the instantaneous ascending lunar node.
 // Receives:
-//	jd: the julian day, as a float64
+//	jd: the julian day in UT, as a float64
 // Returns:
 //	the ecliptic longitude of the instantaneous ascending lunar node.
 // Notes:
@@ -187,7 +188,8 @@ func Node(jde float64) unit.Angle {
 //	Because Chapront and Meeus don't describe their reference frame for this algorithm, there is no rigorous way to reconcile them to JPL.
 //export findAscendingNode
 func FindAscendingNode(jd float64) float64 {
-	d, m, mP, f := dmf(base.J2000Century(jd))
+	jde := deltat.JDE(jd)
+	d, m, mP, f := dmf(base.J2000Century(jde))
 	astroterms := AstroTerms{
 		d:   d,
 		m:   m,
@@ -202,6 +204,6 @@ func FindAscendingNode(jd float64) float64 {
 	for _, term := range nodeTerms {
 		adjust += term.coeff * math.Sin(term.sinFunc(astroterms))
 	}
-	return Node(jd).Add(unit.AngleFromDeg(adjust)).Deg()
+	return Node(jde).Add(unit.AngleFromDeg(adjust)).Deg()
 
 }
@@ -37,16 +37,42 @@ func Horizontal(Δ float64) (π unit.Angle) {
 // Returns:
 //	λʹ: topocentric longitude as an Angle
 func TopocentricLongitude(λ, β, φ unit.Angle, h float64, ε unit.Angle, θ unit.Time, π unit.Angle) (λʹ unit.Angle) {
+	λʹ, _, _ = TopocentricEcliptic(λ, β, 1, φ, h, ε, θ, π)
+	return
+}
+
+// Finds topocentric ecliptic coordinates and distance.
+// Receives:
+//	λ: geocentric ecliptic longitude as an Angle
+//	β: geocentric ecliptic latitude as an Angle
+//	Δ: geocentric distance, in any unit
+//	φ: geographic latitude as an Angle
+//	h: geographic height from sea level, in meters
+//	ε: obliquity as an Angle
+//	θ: local sidereal time as a Time
+//	π: equatorial horizontal parallax as an Angle
+// Returns:
+//	λʹ: topocentric longitude as an Angle
+//	βʹ: topocentric latitude as an Angle
+//	Δʹ: topocentric distance, in the unit of Δ
+// Notes:
+//	Formula 40.6, p. 282. The distance follows from the same rectangular components:
+//	sin π is the Earth's equatorial radius in units of Δ.
+func TopocentricEcliptic(λ, β unit.Angle, Δ float64, φ unit.Angle, h float64, ε unit.Angle, θ unit.Time, π unit.Angle) (λʹ, βʹ unit.Angle, Δʹ float64) {
 	S, C := globe.Earth76.ParallaxConstants(φ, h)
 	sλ, cλ := λ.Sincos()
-	cβ := β.Cos()
+	sβ, cβ := β.Sincos()
 	sε, cε := ε.Sincos()
 	sθ, cθ := θ.Angle().Sincos()
 	sπ := π.Sin()
 	N := cλ*cβ - C*sπ*cθ
-	λʹ = unit.Angle(math.Atan2(sλ*cβ-sπ*(S*sε+C*cε*sθ), N))
+	Y := sλ*cβ - sπ*(S*sε+C*cε*sθ)
+	Z := sβ - sπ*(S*cε-C*sε*sθ)
+	λʹ = unit.Angle(math.Atan2(Y, N))
 	if λʹ < 0 {
 		λʹ += 2 * math.Pi
 	}
+	βʹ = unit.Angle(math.Atan2(Z, math.Hypot(N, Y)))
+	Δʹ = Δ * math.Sqrt(N*N+Y*Y+Z*Z)
 	return
 }
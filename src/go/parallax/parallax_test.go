@@ -50,3 +50,26 @@ func ExampleTopocentricLongitude() {
 	// Output:
 	// λʹ = 181°48′5.0″
 }
+
+func ExampleTopocentricEcliptic() {
+	// exercise, p. 282
+	λʹ, βʹ, Δʹ := parallax.TopocentricEcliptic(
+		unit.NewAngle(' ', 181, 46, 22.5),
+		unit.NewAngle(' ', 2, 17, 26.2),
+		1,
+		unit.NewAngle(' ', 50, 5, 7.8),
+		0,
+		unit.NewAngle(' ', 23, 28, 0.8),
+		unit.NewAngle(' ', 209, 46, 7.9).Time(),
+		unit.NewAngle(' ', 0, 59, 27.7))
+	// semidiameter scales inversely with distance
+	s := unit.NewAngle(' ', 0, 16, 15.5)
+	sʹ := unit.Angle(math.Asin(s.Sin() / Δʹ))
+	fmt.Printf("λʹ = %.1s\n", sexa.FmtAngle(λʹ))
+	fmt.Printf("βʹ = %+.1s\n", sexa.FmtAngle(βʹ))
+	fmt.Printf("sʹ = %.1s\n", sexa.FmtAngle(sʹ))
+	// Output:
+	// λʹ = 181°48′5.0″
+	// βʹ = +1°29′7.1″
+	// sʹ = 16′25.5″
+}
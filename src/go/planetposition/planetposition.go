@@ -33,7 +33,10 @@ import (
 	unit "webeph/unit"
 )
 
-// Mercury-Neptune planet constants suitable for first argument to LoadPlanet.
+// Body constants suitable for first argument to LoadPlanet.
+//
+// Sun, Moon and Pluto have no VSOP87 file.  They share the numbering so that
+// one constant identifies a body everywhere in the library.
 const (
 	Mercury = iota
 	Venus
@@ -43,14 +46,17 @@ const (
 	Saturn
 	Sun
 	Moon
+	Uranus
+	Neptune
+	Pluto
 	nPlanets // sad practicality
 )
 
 // parallel arrays, indexed by planet constants.
 var (
-	// extensions of VSOP87B files
+	// extensions of VSOP87B files, empty for bodies without one
 	ext = [nPlanets]string{
-		"mer", "ven", "ear", "mar", "jup", "sat", "ura", "nep"}
+		"mer", "ven", "ear", "mar", "jup", "sat", "", "", "ura", "nep", ""}
 
 	// planet names as found in VSOP87B files
 	b7 = [nPlanets]string{
@@ -60,8 +66,11 @@ var (
 		"MARS   ",
 		"JUPITER",
 		"SATURN ",
+		"",
+		"",
 		"URANUS ",
 		"NEPTUNE",
+		"",
 	}
 	mars = &V87Planet{
 		L: [6][]Abc{
@@ -191,6 +200,127 @@ var (
 		},
 		Ibody: Earth,
 	}
+	uranus = &V87Planet{
+		L: [6][]Abc{
+			// L0
+			{
+				{5.48129294, 0., 0.},
+				{0.09260408, 0.8910642, 74.7815986},
+				{0.01504248, 3.6271926, 1.4844727},
+				{0.00365982, 1.899622, 73.297126},
+				{0.00272328, 3.358237, 149.563197},
+				{0.00070328, 5.39254, 63.73590},
+				{0.00068893, 6.09292, 76.26607},
+				{0.00061999, 2.26952, 2.96895},
+				{0.00061951, 2.85099, 11.04570},
+				{0.00026469, 3.14152, 71.81265},
+				{0.00025711, 6.11380, 454.90937},
+				{0.00021079, 4.36059, 148.07872},
+				{0.00017819, 1.74437, 36.64856},
+				{0.00014613, 4.73732, 3.93215},
+				{0.00011163, 5.82682, 224.34480},
+				{0.00010998, 0.48865, 138.51750},
+			},
+			// L1
+			{
+				{74.7815985673, 0., 0.},
+				{0.00154458, 5.242017, 74.781599},
+				{0.00024456, 1.71256, 1.48447},
+				{0.00009258, 0.4284, 11.0457},
+				{0.00008266, 1.5022, 63.7359},
+				{0.00007842, 1.3198, 149.5632},
+			},
+		},
+		B: [6][]Abc{
+			// B0
+			{
+				{0.01346278, 2.6187781, 74.7815986},
+				{0.00062341, 5.08111, 149.56320},
+				{0.00061601, 3.14159, 0.},
+				{0.00009964, 1.6160, 76.2661},
+				{0.00009926, 0.5763, 73.2971},
+			},
+		},
+		R: [6][]Abc{
+			// R0
+			{
+				{19.21264848, 0., 0.},
+				{0.88784984, 5.60377527, 74.78159857},
+				{0.03440836, 0.3283610, 73.2971259},
+				{0.02055653, 1.7829517, 149.5631971},
+				{0.00649322, 4.522473, 76.266071},
+				{0.00602248, 3.860038, 63.735898},
+				{0.00496404, 1.401399, 454.909367},
+				{0.00338526, 1.580027, 138.517497},
+				{0.00243508, 1.570866, 71.812653},
+				{0.00190522, 1.998094, 1.484473},
+				{0.00161858, 2.791379, 148.078724},
+				{0.00143706, 1.383686, 11.045700},
+			},
+			// R1
+			{
+				{0.01479896, 3.6720571, 74.7815986},
+				{0.00071212, 6.22601, 63.73590},
+				{0.00068627, 6.13411, 149.56320},
+			},
+		},
+		Ibody: Uranus,
+	}
+	neptune = &V87Planet{
+		L: [6][]Abc{
+			// L0
+			{
+				{5.31188633, 0., 0.},
+				{0.01798476, 2.9010127, 38.1330356},
+				{0.01019728, 0.4858092, 1.4844727},
+				{0.00124532, 4.830081, 36.648563},
+				{0.00042064, 5.41055, 2.96895},
+				{0.00037715, 6.09222, 35.16409},
+				{0.00033785, 1.24489, 76.26607},
+				{0.00016483, 0.00008, 491.55793},
+				{0.00009199, 4.9375, 39.6175},
+				{0.00008994, 0.2746, 175.1661},
+			},
+			// L1
+			{
+				{38.1330356378, 0., 0.},
+				{0.00016604, 4.86319, 1.48447},
+				{0.00015807, 2.27923, 38.13304},
+				{0.00003335, 3.6820, 76.2661},
+			},
+		},
+		B: [6][]Abc{
+			// B0
+			{
+				{0.03088623, 1.4410437, 38.1330356},
+				{0.0002778, 5.91272, 76.26607},
+				{0.00027624, 0., 0.},
+				{0.00015448, 3.50877, 39.61751},
+				{0.00015355, 2.52124, 36.64856},
+			},
+		},
+		R: [6][]Abc{
+			// R0
+			{
+				{30.07013206, 0., 0.},
+				{0.27062259, 1.32999459, 38.13303564},
+				{0.01691764, 3.2518614, 36.6485629},
+				{0.00807831, 5.185928, 1.484473},
+				{0.00537761, 4.521139, 35.164090},
+				{0.00495726, 1.571057, 491.557929},
+				{0.00274572, 1.845523, 175.166060},
+				{0.00135134, 3.372206, 39.617508},
+				{0.00121802, 5.797544, 76.266071},
+				{0.00100895, 0.377027, 73.297126},
+			},
+			// R1
+			{
+				{0.00236339, 0.704980, 38.133036},
+				{0.0001322, 3.32015, 1.48447},
+			},
+		},
+		Ibody: Neptune,
+	}
 )
 
 type Abc struct {
@@ -232,6 +362,9 @@ func LoadPlanetPath(ibody int, path string) (*V87Planet, error) {
 	if ibody < 0 || ibody >= nPlanets {
 		return nil, errors.New("Invalid planet.")
 	}
+	if ext[ibody] == "" {
+		return nil, errors.New("No VSOP87 file for this body.")
+	}
 	parsed := path + "VSOP87B." + ext[ibody]
 	resp, err := http.Get(parsed)
 	if err != nil {
@@ -399,3 +532,11 @@ func GetSaturn() *V87Planet {
 func GetEarth() *V87Planet {
 	return earth
 }
+
+func GetUranus() *V87Planet {
+	return uranus
+}
+
+func GetNeptune() *V87Planet {
+	return neptune
+}
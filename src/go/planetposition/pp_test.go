@@ -90,3 +90,12 @@ func TestFK5(t *testing.T) {
 		t.Error(Δβ)
 	}
 }
+
+func TestLoadPlanetPathNoFile(t *testing.T) {
+	// Sun, Moon and Pluto have no VSOP87 file; no request should be made.
+	for _, ibody := range []int{pp.Sun, pp.Moon, pp.Pluto} {
+		if _, err := pp.LoadPlanetPath(ibody, "http://invalid/"); err == nil {
+			t.Errorf("body %d: expected error", ibody)
+		}
+	}
+}
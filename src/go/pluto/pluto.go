@@ -0,0 +1,130 @@
+// Pluto: Chapter 37, Pluto.
+//
+// The periodic terms of table 37.A are valid only for the years 1885 to
+// 2099.  Outside of that range results degrade quickly.
+package pluto
+
+import (
+	"errors"
+	"math"
+
+	base "webeph/base"
+	coord "webeph/coord"
+	precess "webeph/precess"
+	unit "webeph/unit"
+)
+
+// The range of table 37.A: from 1885 January 1 up to 2100 January 1.
+const (
+	FirstJDE = 2409542.5
+	LastJDE  = 2488069.5
+)
+
+// ErrRange is returned for a date outside the range of table 37.A.
+var ErrRange = errors.New("Pluto is valid only from 1885 to 2099.")
+
+// InRange reports whether a date lies within the range of table 37.A.
+func InRange(jde float64) bool {
+	return jde >= FirstJDE && jde < LastJDE
+}
+
+// Heliocentric returns J2000 heliocentric coordinates of Pluto.
+//
+// Results are referenced to the standard equinox and ecliptic of J2000.
+//
+//	l is heliocentric longitude.
+//	b is heliocentric latitude.
+//	r is heliocentric range in AU.
+func Heliocentric(jde float64) (l, b unit.Angle, r float64) {
+	T := base.J2000Century(jde)
+	J := 34.35 + 3034.9057*T
+	S := 50.08 + 1222.1138*T
+	P := 238.96 + 144.96*T
+	var Σl, Σb, Σr float64
+	for i := range t37 {
+		t := &t37[i]
+		sα, cα := math.Sincos((t.i*J + t.j*S + t.k*P) * math.Pi / 180)
+		Σl += t.lA*sα + t.lB*cα
+		Σb += t.bA*sα + t.bB*cα
+		Σr += t.rA*sα + t.rB*cα
+	}
+	// (37.1) p. 264
+	l = unit.AngleFromDeg(238.958116 + 144.96*T + Σl*1e-6).Mod1()
+	b = unit.AngleFromDeg(-3.908239 + Σb*1e-6)
+	r = 40.7241346 + Σr*1e-7
+	return
+}
+
+// Position returns heliocentric coordinates of Pluto at equinox and ecliptic
+// of date.
+//
+// Results are consistent with planetposition.V87Planet.Position, so that
+// Pluto can be carried through the same geocentric reduction as the other
+// planets.
+//
+//	L is heliocentric longitude.
+//	B is heliocentric latitude.
+//	R is heliocentric range in AU.
+func Position(jde float64) (L, B unit.Angle, R float64) {
+	l, b, R := Heliocentric(jde)
+	eclFrom := &coord.Ecliptic{
+		Lat: b,
+		Lon: l,
+	}
+	eclTo := &coord.Ecliptic{}
+	precess.EclipticPosition(eclFrom, eclTo, 2000, base.JDEToJulianYear(jde), 0, 0)
+	return eclTo.Lon, eclTo.Lat, R
+}
+
+// Table 37.A, p. 265.
+//
+// Coefficients of J, S, and P, then the sine and cosine coefficients for
+// longitude, latitude, and radius vector.
+var t37 = []struct {
+	i, j, k                float64
+	lA, lB, bA, bB, rA, rB float64
+}{
+	{0, 0, 1, -19799805, 19850055, -5452852, -14974862, 66865439, 68951812},
+	{0, 0, 2, 897144, -4954829, 3527812, 1672790, -11827535, -332538},
+	{0, 0, 3, 611149, 1211027, -1050748, 327647, 1593179, -1438890},
+	{0, 0, 4, -341243, -189585, 178690, -292153, -18444, 483220},
+	{0, 0, 5, 129287, -34992, 18650, 100340, -65977, -85431},
+	{0, 0, 6, -38164, 30893, -30697, -25823, 31174, -6032},
+	{0, 1, -1, 20442, -9987, 4878, 11248, -5794, 22161},
+	{0, 1, 0, -4063, -5071, 226, -64, 4601, 4032},
+	{0, 1, 1, -6016, -3336, 2030, -836, -1729, 234},
+	{0, 1, 2, -3956, 3039, 69, -604, -415, 702},
+	{0, 1, 3, -667, 3572, -247, -567, 239, 723},
+	{0, 2, -2, 1276, 501, -57, 1, 67, -67},
+	{0, 2, -1, 1152, -917, -122, 175, 1034, -451},
+	{0, 2, 0, 630, -1277, -49, -164, -129, 504},
+	{1, -1, 0, 2571, -459, -197, 199, 480, -231},
+	{1, -1, 1, 899, -1449, -25, 217, 2, -441},
+	{1, 0, -3, -1016, 1043, 589, -248, -3359, 265},
+	{1, 0, -2, -2343, -1012, -269, 711, 7856, -7832},
+	{1, 0, -1, 7042, 788, 185, 193, 36, 45763},
+	{1, 0, 0, 1199, -338, 315, 807, 8663, 8547},
+	{1, 0, 1, 418, -67, -130, -43, -809, -769},
+	{1, 0, 2, 120, -274, 5, 3, 263, -144},
+	{1, 0, 3, -60, -159, 2, 17, -126, 32},
+	{1, 0, 4, -82, -29, 2, 5, -35, -16},
+	{1, 1, -3, -36, -29, 2, 3, -19, -4},
+	{1, 1, -2, -40, 7, 3, 1, -15, 8},
+	{1, 1, -1, -14, 22, 2, -1, -4, 12},
+	{1, 1, 0, 4, 13, 1, -1, 5, 6},
+	{1, 1, 1, 5, 2, 0, -1, 3, 1},
+	{1, 1, 3, -1, 0, 0, 0, 6, -2},
+	{2, 0, -6, 2, 0, 0, -2, 2, 2},
+	{2, 0, -5, -4, 5, 2, 2, -2, -2},
+	{2, 0, -4, 4, -7, -7, 0, 14, 13},
+	{2, 0, -3, 14, 24, 10, -8, -63, 13},
+	{2, 0, -2, -49, -34, -3, 20, 136, -236},
+	{2, 0, -1, 163, -48, 6, 5, 273, 1065},
+	{2, 0, 0, 9, -24, 14, 17, 251, 149},
+	{2, 0, 1, -4, 1, -2, 0, -25, -9},
+	{2, 0, 2, -3, 1, 0, 0, -9, -2},
+	{2, 0, 3, 1, 3, 0, 0, -8, 7},
+	{3, 0, -2, -3, -1, 0, 1, 9, 1},
+	{3, 0, -1, 5, -3, 0, 0, -20, -12},
+	{3, 0, 0, 0, 0, 0, 1, -2, 3},
+}
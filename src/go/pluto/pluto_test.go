@@ -0,0 +1,46 @@
+package pluto_test
+
+import (
+	"fmt"
+	"testing"
+
+	julian "webeph/julian"
+	pluto "webeph/pluto"
+	sexa "webeph/sexagesimal"
+	testutils "webeph/testutils"
+)
+
+func ExampleHeliocentric() {
+	// Example 37.a, p. 266.
+	l, b, r := pluto.Heliocentric(2448908.5)
+	fmt.Printf("l: %.5j\n", sexa.FmtAngle(l))
+	fmt.Printf("b: %.5j\n", sexa.FmtAngle(b))
+	fmt.Printf("r: %.6f\n", r)
+	// Output:
+	// l: 232°.74071
+	// b: 14°.58782
+	// r: 29.711111
+}
+
+func TestPosition(t *testing.T) {
+	// Precessing from J2000 to J2000 should change nothing.
+	jde := julian.CalendarGregorianToJD(2000, 1, 1.5)
+	l, b, r := pluto.Heliocentric(jde)
+	L, B, R := pluto.Position(jde)
+	if !testutils.CheckTolerance(L.Deg(), l.Deg(), testutils.SecondTolerance) ||
+		!testutils.CheckTolerance(B.Deg(), b.Deg(), testutils.SecondTolerance) ||
+		R != r {
+		t.Errorf("TestPosition: expected %v, %v, %v to be %v, %v, %v", L.Deg(), B.Deg(), R, l.Deg(), b.Deg(), r)
+	}
+}
+
+func TestInRange(t *testing.T) {
+	for _, c := range []struct {
+		y    int
+		want bool
+	}{{1884, false}, {1885, true}, {2099, true}, {2100, false}} {
+		if got := pluto.InRange(julian.CalendarGregorianToJD(c.y, 6, 1)); got != c.want {
+			t.Errorf("%d: %v, want %v", c.y, got, c.want)
+		}
+	}
+}
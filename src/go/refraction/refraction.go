@@ -0,0 +1,42 @@
+// Refraction: Chapter 16, Atmospheric Refraction.
+//
+// Functions here assume atmospheric pressure of 1010 mb, temperature of
+// 10°C, and yellow light.  Both formulas degrade below about −1° of altitude,
+// where refraction is in any case unpredictable.
+package refraction
+
+import (
+	"math"
+
+	unit "webeph/unit"
+)
+
+const (
+	cRad = math.Pi / 180
+)
+
+// Bennett returns refraction for obtaining true altitude.
+//
+// Argument h0 is the apparent altitude, as observed.
+//
+// Result R is the amount of refraction.  Subtract it from h0 to obtain
+// the true altitude.
+func Bennett(h0 unit.Angle) (R unit.Angle) {
+	// (16.3) p. 106, with the constant Meeus gives to make R zero at 90°
+	const c1 = 7.31 * cRad * cRad
+	const c2 = 4.4 * cRad
+	return unit.AngleFromMin(1/math.Tan(h0.Rad()+c1/(h0.Rad()+c2)) + .0013515)
+}
+
+// Saemundsson returns refraction for obtaining apparent altitude.
+//
+// Argument h is the true altitude, as computed.
+//
+// Result R is the amount of refraction.  Add it to h to obtain the
+// apparent altitude.
+func Saemundsson(h unit.Angle) (R unit.Angle) {
+	// (16.4) p. 106, with the constant Meeus gives to make R zero at 90°
+	const c1 = 10.3 * cRad * cRad
+	const c2 = 5.11 * cRad
+	return unit.AngleFromMin(1.02/math.Tan(h.Rad()+c1/(h.Rad()+c2)) + .0019279)
+}
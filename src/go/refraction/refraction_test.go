@@ -0,0 +1,41 @@
+package refraction_test
+
+import (
+	"math"
+	"testing"
+
+	refraction "webeph/refraction"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+)
+
+func TestHorizon(t *testing.T) {
+	// p. 106: refraction at the horizon is about 34′.
+	R := refraction.Bennett(0).Min()
+	if math.Abs(R-34.5) > .1 {
+		t.Errorf("Bennett(0) = %.3f′, want about 34.5′", R)
+	}
+}
+
+func TestZenith(t *testing.T) {
+	for _, R := range []unit.Angle{
+		refraction.Bennett(unit.AngleFromDeg(90)),
+		refraction.Saemundsson(unit.AngleFromDeg(90)),
+	} {
+		if math.Abs(R.Min()) > 1e-5 {
+			t.Errorf("refraction at zenith = %g′, want 0", R.Min())
+		}
+	}
+}
+
+func TestConsistency(t *testing.T) {
+	// p. 107: the two formulas agree within about 4″.
+	for d := 0.; d <= 90; d += 5 {
+		h := unit.AngleFromDeg(d)
+		h0 := h + refraction.Saemundsson(h)
+		back := h0 - refraction.Bennett(h0)
+		if !testutils.CheckTolerance(back.Deg(), h.Deg(), 4*testutils.SecondTolerance) {
+			t.Errorf("h = %g°: round trip gives %.6f°", d, back.Deg())
+		}
+	}
+}
@@ -0,0 +1,54 @@
+//go:build js && wasm
+
+package returns
+
+import (
+	unit "webeph/unit"
+	web "webeph/web"
+)
+
+var (
+	returnContainer = [14]float64{}
+)
+
+// Gets the array containing a return chart.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for the time, the longitude and the twelve house cusps of a return.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getReturnContainer
+func GetReturnContainer() *[14]float64 {
+	return &returnContainer
+}
+
+// Finds the next return of a body.
+// Receives:
+//	natalJD: the Julian day of birth, in UT
+//	jdStart: the start of the search, as a Julian day in UT
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height above mean sea level, in meters
+//	planet: the required body, as a planetposition constant
+//	system: the house system, as a houses package constant
+//	mode: 0 for a tropical return, 1 for a precession-corrected one
+// Returns:
+//	true if the return was found; false on error
+// Notes:
+//	Stores the Julian day of the return, the longitude of the body and the twelve cusps, in degrees in the zodiac set by
+//	setZodiac. Use getReturnContainer to recover results. On error, sets ErrMsg and zeroes the container.
+//export findReturn
+func findReturn(natalJD, jdStart float64, φ, ο unit.Angle, h float64, planet, system, mode int) bool {
+	r, err := Find(planet, natalJD, jdStart, &web.Site{Lat: φ, Lon: ο, Height: h}, system, mode)
+	if err != nil {
+		web.ErrMsg = err.Error()
+		returnContainer = [14]float64{}
+		return false
+	}
+	returnContainer[0], returnContainer[1] = r.JD, r.Lon.Deg()
+	for i, cusp := range r.Cusps {
+		returnContainer[2+i] = cusp.Deg()
+	}
+	return true
+}
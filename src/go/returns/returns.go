@@ -0,0 +1,85 @@
+// Returns: charts cast for the moment a body comes back to its natal longitude.
+//
+// The Sun returns about once a year and the Moon about once a month; a
+// planet can pass its natal longitude three times around a retrograde loop,
+// and the first of them is the return.  A precession-corrected return holds
+// the natal place among the stars rather than from the equinox, so it falls
+// some twenty minutes later each year for the Sun.
+package returns
+
+import (
+	"errors"
+
+	ayanamsa "webeph/ayanamsa"
+	crossing "webeph/crossing"
+	unit "webeph/unit"
+	web "webeph/web"
+)
+
+// Return constants.
+const (
+	Tropical  = iota // back to the natal longitude in the zodiac set by web.SetZodiac
+	Precessed        // back to the natal longitude carried forward by precession
+)
+
+// Return is a return chart.
+type Return struct {
+	JD    float64        // Julian day, in UT
+	Lon   unit.Angle     // the longitude of the body at the return, in the zodiac set by web.SetZodiac
+	Cusps [12]unit.Angle // house cusps for the site, house 1 first, in the zodiac set by web.SetZodiac
+}
+
+const (
+	// Days searched at a time: more than a year, so the Sun and the Moon are found in the first span.
+	span = 400.
+	// Days searched in all: past the longest retrograde loop of Pluto around its return.
+	maxSearch = 250 * 365.25
+)
+
+// Finds the next return of a body.
+// Receives:
+//	planet: the required body, as a planetposition constant
+//	natalJD: the Julian day of birth, in UT
+//	jdStart: the start of the search, as a Julian day in UT
+//	site: the observer, for the natal longitude, the search and the houses
+//	system: the house system, as a houses package constant
+//	mode: Tropical or Precessed
+// Returns:
+//	r: the first return after jdStart
+//	err: any errors encountered, or an error if there is no return within 250 years
+// Notes:
+//	Longitudes are topocentric, as for the natal chart: the natal longitude is found for the same site, so a return cast
+//	away from the birthplace holds the natal longitude as seen from there. Houses come from web.FindHouseCusps.
+//	The crossing is refined by bisection to about a second, by crossing.Find or crossing.FindPrecessed.
+//	In a sidereal zodiac every return already holds the natal place among the stars, so Precessed is the same as Tropical.
+func Find(planet int, natalJD, jdStart float64, site *web.Site, system, mode int) (r Return, err error) {
+	if mode != Tropical && mode != Precessed {
+		return r, errors.New("Invalid return.")
+	}
+	natal, _, _, err := web.FindPosition(natalJD, planet, site)
+	if err != nil {
+		return
+	}
+	precessed := mode == Precessed && web.Zodiac() == web.Tropical
+	for jd := jdStart; jd < jdStart+maxSearch; jd += span {
+		var found []crossing.Crossing
+		if precessed {
+			found, err = crossing.FindPrecessed(planet, natal, natalJD, jd, jd+span, site)
+		} else {
+			found, err = crossing.Find(planet, natal, jd, jd+span, site)
+		}
+		if err != nil {
+			return
+		}
+		if len(found) == 0 {
+			continue
+		}
+		r.JD, r.Lon = found[0].JD, natal
+		if precessed {
+			r.Lon = (natal + ayanamsa.Precession(natalJD, r.JD)).Mod1()
+		}
+		r.Cusps, err = web.FindHouseCusps(r.JD, site, system)
+		return
+	}
+	return r, errors.New("No return found.")
+}
@@ -0,0 +1,101 @@
+package returns_test
+
+import (
+	"testing"
+
+	ayanamsa "webeph/ayanamsa"
+	houses "webeph/houses"
+	julian "webeph/julian"
+	pp "webeph/planetposition"
+	returns "webeph/returns"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+	web "webeph/web"
+	zabinski "webeph/zabinski"
+)
+
+var (
+	london  = &web.Site{Lat: unit.AngleFromDeg(51.5), Lon: unit.AngleFromDeg(-0.1)}
+	natal   = julian.CalendarGregorianToJD(1990, 5, 15.5)
+	jan2022 = julian.CalendarGregorianToJD(2022, 1, 1)
+)
+
+// Checks that the body is back at its natal longitude as seen from the site, and that the houses are those of the moment.
+func checkReturn(t *testing.T, planet int, r returns.Return) {
+	λ, _, _, err := web.FindPosition(r.JD, planet, london)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !testutils.CheckTolerance(zabinski.FindSignedDiff(λ, r.Lon), 0, testutils.SecondTolerance) {
+		t.Errorf("longitude %v, want %v", λ.Deg(), r.Lon.Deg())
+	}
+	cusps, _ := web.FindHouseCusps(r.JD, london, houses.Placidus)
+	if cusps != r.Cusps {
+		t.Errorf("cusps %v, want %v", r.Cusps, cusps)
+	}
+}
+
+func TestSolar(t *testing.T) {
+	tropical, err := returns.Find(pp.Sun, natal, jan2022, london, houses.Placidus, returns.Tropical)
+	if err != nil {
+		t.Fatal(err)
+	}
+	natalλ, _, _, _ := web.FindPosition(natal, pp.Sun, london)
+	if tropical.Lon != natalλ {
+		t.Errorf("returned to %v, want %v", tropical.Lon.Deg(), natalλ.Deg())
+	}
+	if birthday := julian.CalendarGregorianToJD(2022, 5, 15); tropical.JD < birthday-1 || tropical.JD > birthday+1 {
+		t.Errorf("return %v, want about %v", julian.JDToCalendar(tropical.JD), julian.JDToCalendar(birthday))
+	}
+	checkReturn(t, pp.Sun, tropical)
+	precessed, err := returns.Find(pp.Sun, natal, jan2022, london, houses.Placidus, returns.Precessed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkReturn(t, pp.Sun, precessed)
+	// 32 years of precession, some 27′, take the Sun about 11 hours.
+	if late := (precessed.JD - tropical.JD) * 24; late < 10.5 || late > 11.5 {
+		t.Errorf("precessed return %v hours later", late)
+	}
+}
+
+func TestSidereal(t *testing.T) {
+	// A fixed ayanamsa moves with precession, so a sidereal return is a precession-corrected one.
+	precessed, _ := returns.Find(pp.Sun, natal, jan2022, london, houses.Placidus, returns.Precessed)
+	defer web.SetZodiac(web.Tropical)
+	web.SetZodiac(ayanamsa.Lahiri)
+	for _, mode := range []int{returns.Tropical, returns.Precessed} {
+		sidereal, err := returns.Find(pp.Sun, natal, jan2022, london, houses.Placidus, mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !testutils.CheckTolerance(sidereal.JD, precessed.JD, testutils.JulianMinuteTolerance) {
+			t.Errorf("mode %d: sidereal return %v, precessed %v", mode, sidereal.JD, precessed.JD)
+		}
+	}
+}
+
+func TestLunar(t *testing.T) {
+	r, err := returns.Find(pp.Moon, natal, jan2022, london, houses.Placidus, returns.Tropical)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.JD < jan2022 || r.JD > jan2022+27.6 {
+		t.Errorf("lunar return %v", julian.JDToCalendar(r.JD))
+	}
+	checkReturn(t, pp.Moon, r)
+	// Parallax moves the Moon by up to a degree, so at a topocentric return it is away from its geocentric natal place.
+	natalλ, _, _, _ := web.FindPosition(natal, pp.Moon, nil)
+	if λ, _, _, _ := web.FindPosition(r.JD, pp.Moon, nil); testutils.CheckTolerance(zabinski.FindSignedDiff(λ, natalλ), 0, testutils.StandardTolerance) {
+		t.Error("the lunar return should be topocentric")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := returns.Find(pp.Earth, natal, jan2022, london, houses.Placidus, returns.Tropical); err == nil {
+		t.Error("the Earth has no return")
+	}
+	if _, err := returns.Find(pp.Sun, natal, jan2022, london, houses.Placidus, returns.Precessed+1); err == nil {
+		t.Error("an unknown mode should be an error")
+	}
+}
@@ -0,0 +1,193 @@
+// Rise: Chapter 15, Rising, Transit, and Setting.
+//
+// Instead of interpolating between positions on three days, each event is
+// found by repeating the correction Δm of p. 103 with the position recomputed
+// at the corrected time, until the correction vanishes.  This keeps the
+// accuracy of the theory for fast bodies such as the Moon.
+package rise
+
+import (
+	"math"
+
+	globe "webeph/globe"
+	sidereal "webeph/sidereal"
+	unit "webeph/unit"
+)
+
+// Status constants.
+const (
+	Normal      = iota // the body rises and sets
+	Circumpolar        // the body stays above the horizon all day
+	NeverRises         // the body stays below the horizon all day
+)
+
+// Horizon sets the altitude at which a body rises and sets.
+type Horizon struct {
+	Altitude   unit.Angle // geometric altitude of the horizon: zero for the sea horizon
+	Refraction unit.Angle // refraction at that altitude
+	UpperLimb  bool       // true to rise and set at the upper limb rather than the center
+	Height     float64    // height of the observer above the horizon, in meters, for its dip
+}
+
+// Standard is the usual horizon for sunrise and moonrise: 34′ of refraction, upper limb, no dip.
+var Standard = Horizon{Refraction: unit.AngleFromMin(34), UpperLimb: true}
+
+// Times are the events of one day.
+type Times struct {
+	Rise    float64 // Julian day in UT; zero if the body does not rise within the day
+	Transit float64 // Julian day in UT of the upper transit; zero if none within the day
+	Set     float64 // Julian day in UT; zero if the body does not set within the day
+	Status  int     // one of the status constants, at transit
+}
+
+// Position gives the place of a body.
+// Receives:
+//	jd: the Julian day, in UT
+// Returns:
+//	α: the geocentric right ascension, as a unit.RA
+//	δ: the geocentric declination, as a unit.Angle
+//	π: the equatorial horizontal parallax, as a unit.Angle
+//	s: the semidiameter, as a unit.Angle
+type Position func(jd float64) (α unit.RA, δ, π, s unit.Angle)
+
+const (
+	// Sidereal days per solar day.
+	siderealRate = 1.00273790935
+	// Dip of the horizon, in arc minutes per square root of a meter, refraction included.
+	dipRate = 1.76
+	// Width of the final correction, in days: about a tenth of a second.
+	tolerance = 1e-6
+	// Iterations allowed. The Moon converges in four or five.
+	maxIterations = 20
+)
+
+// StandardAltitude finds h0, the geocentric altitude of the center of a body at rising and setting.
+//
+// Arguments π and s are the parallax and semidiameter of the body.  Parallax
+// is added because positions are geocentric.  For the Moon with the standard
+// horizon this is Meeus's 0.7275π − 0°34′.
+func (hz *Horizon) StandardAltitude(π, s unit.Angle) unit.Angle {
+	h0 := hz.Altitude - hz.Refraction + π
+	if hz.UpperLimb {
+		h0 -= s
+	}
+	if hz.Height > 0 {
+		h0 -= unit.AngleFromMin(dipRate * math.Sqrt(hz.Height))
+	}
+	return h0
+}
+
+// Finds the rising, transit and setting of a body within a day.
+// Receives:
+//	position: the place of the body, as a function of the Julian day
+//	jd: the start of the day, as a Julian day in UT
+//	g: the observer, as a globe.Coord with longitude measured westward
+//	horizon: the horizon, or nil for Standard
+// Returns:
+//	times: the events in [jd, jd+1)
+// Notes:
+//	The day need not start at 0ʰ UT: start it at local midnight to get the events of the local date.
+//	The Moon can skip a rising or setting on some days; that event is then zero with status Normal.
+func Find(position Position, jd float64, g *globe.Coord, horizon *Horizon) (times Times) {
+	if horizon == nil {
+		horizon = &Standard
+	}
+	// first estimate of transit, (15.2) p. 102
+	α, _, _, _ := position(jd)
+	m0 := (α.Angle() + g.Lon - sidereal.Apparent(0, 0, jd).Angle()).Mod1().Rad() / (2 * math.Pi)
+	transit, ok := converge(position, jd+m0/siderealRate, jd, g, horizon, 0)
+	if ok {
+		times.Transit = transit
+	} else {
+		// The Moon can skip a transit, yet still rise or set within the day.
+		transit = jd + m0/siderealRate
+	}
+	_, δ, π, s := position(transit)
+	switch cosH0 := cosHourAngle(g.Lat, δ, horizon.StandardAltitude(π, s)); {
+	case cosH0 > 1:
+		times.Status = NeverRises
+		return
+	case cosH0 < -1:
+		times.Status = Circumpolar
+		return
+	default:
+		// (15.1) p. 102
+		H0 := math.Acos(cosH0) / (2 * math.Pi) / siderealRate
+		times.Rise, _ = converge(position, transit-H0, jd, g, horizon, -1)
+		times.Set, _ = converge(position, transit+H0, jd, g, horizon, 1)
+	}
+	return
+}
+
+// Finds the cosine of the hour angle at which a body reaches an altitude.
+// Receives:
+//	φ: the geographic latitude
+//	δ: the declination
+//	h0: the altitude
+// Returns:
+//	cos H0: above 1 if the body stays below h0, below -1 if it stays above
+func cosHourAngle(φ, δ, h0 unit.Angle) float64 {
+	// (15.1) p. 102
+	return (h0.Sin() - φ.Sin()*δ.Sin()) / (φ.Cos() * δ.Cos())
+}
+
+// Refines the time of an event within a day.
+// Receives:
+//	position: the place of the body
+//	t: the first estimate, as a Julian day in UT
+//	jd: the start of the day, as a Julian day in UT
+//	g: the observer
+//	horizon: the horizon
+//	event: 0 for transit, -1 for rising, 1 for setting
+// Returns:
+//	t: the time of the event, or zero
+//	ok: false if the event does not happen within the day
+// Notes:
+//	An event that converges outside the day is tried again one day over, to catch the Moon drifting later by the day.
+func converge(position Position, t, jd float64, g *globe.Coord, horizon *Horizon, event int) (float64, bool) {
+	t, ok := iterateEvent(position, t, g, horizon, event)
+	switch {
+	case !ok:
+		return 0, false
+	case t < jd:
+		t, ok = iterateEvent(position, t+1, g, horizon, event)
+	case t >= jd+1:
+		t, ok = iterateEvent(position, t-1, g, horizon, event)
+	}
+	if !ok || t < jd || t >= jd+1 {
+		return 0, false
+	}
+	return t, true
+}
+
+// Repeats the correction Δm until it vanishes.
+// Receives:
+//	position: the place of the body
+//	t: the first estimate, as a Julian day in UT
+//	g: the observer
+//	horizon: the horizon
+//	event: 0 for transit, -1 for rising, 1 for setting
+// Returns:
+//	t: the time of the event
+//	ok: false if the body does not reach the horizon near t, or if the correction does not vanish within maxIterations
+func iterateEvent(position Position, t float64, g *globe.Coord, horizon *Horizon, event int) (float64, bool) {
+	for i := 0; i < maxIterations; i++ {
+		α, δ, π, s := position(t)
+		// local hour angle, p. 103; the longitude is measured westward
+		H := sidereal.Apparent(0, 0, t).Angle() - g.Lon - α.Angle()
+		target := 0.
+		if event != 0 {
+			cosH0 := cosHourAngle(g.Lat, δ, horizon.StandardAltitude(π, s))
+			if cosH0 < -1 || cosH0 > 1 {
+				return 0, false
+			}
+			target = float64(event) * math.Acos(cosH0)
+		}
+		Δm := math.Remainder(H.Rad()-target, 2*math.Pi) / (2 * math.Pi) / siderealRate
+		t -= Δm
+		if math.Abs(Δm) < tolerance {
+			return t, true
+		}
+	}
+	return 0, false
+}
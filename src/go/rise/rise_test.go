@@ -0,0 +1,93 @@
+package rise_test
+
+import (
+	"math"
+	"testing"
+
+	globe "webeph/globe"
+	julian "webeph/julian"
+	pp "webeph/planetposition"
+	rise "webeph/rise"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+	web "webeph/web"
+)
+
+var boston = &web.Site{Lat: unit.AngleFromDeg(42 + 20./60), Lon: unit.AngleFromDeg(-71 - 5./60)}
+
+func TestExample15a(t *testing.T) {
+	// Example 15.a, p. 103: Venus at Boston, 1988 March 20.
+	day := julian.CalendarGregorianToJD(1988, 3, 20)
+	times, err := web.FindRiseTransitSet(day, pp.Venus, boston, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if times.Status != rise.Normal {
+		t.Errorf("status %d, want %d", times.Status, rise.Normal)
+	}
+	for _, e := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"rise", times.Rise, day + .51766},
+		{"transit", times.Transit, day + .81980},
+		{"set", times.Set, day + .12130},
+	} {
+		if !testutils.CheckTolerance(e.got, e.want, testutils.JulianMinuteTolerance) {
+			t.Errorf("%s: %v, want %v", e.name, e.got, e.want)
+		}
+	}
+}
+
+func TestPolar(t *testing.T) {
+	tromsø := &web.Site{Lat: unit.AngleFromDeg(69.65), Lon: unit.AngleFromDeg(18.96)}
+	for _, c := range []struct {
+		month  int
+		status int
+	}{
+		{6, rise.Circumpolar},
+		{12, rise.NeverRises},
+	} {
+		times, err := web.FindRiseTransitSet(julian.CalendarGregorianToJD(2022, c.month, 21), pp.Sun, tromsø, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if times.Status != c.status || times.Rise != 0 || times.Set != 0 || times.Transit == 0 {
+			t.Errorf("month %d: %+v, want status %d", c.month, times, c.status)
+		}
+	}
+}
+
+func TestMoonSkipsTransit(t *testing.T) {
+	// The Moon transits Boston just before midnight UT on 2022 June 8, and just after on June 10.
+	times, err := web.FindRiseTransitSet(julian.CalendarGregorianToJD(2022, 6, 9), pp.Moon, boston, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if times.Transit != 0 || times.Rise == 0 || times.Set == 0 {
+		t.Errorf("%+v, want rise and set without transit", times)
+	}
+}
+
+func TestHorizon(t *testing.T) {
+	// A higher observer sees the Sun rise earlier.
+	day := julian.CalendarGregorianToJD(2022, 2, 11)
+	low, _ := web.FindRiseTransitSet(day, pp.Sun, boston, nil)
+	horizon := rise.Standard
+	horizon.Height = 100
+	high, _ := web.FindRiseTransitSet(day, pp.Sun, boston, &horizon)
+	if high.Rise >= low.Rise || high.Set <= low.Set {
+		t.Errorf("from 100 m: %+v, at sea level: %+v", high, low)
+	}
+}
+
+func TestNoConvergence(t *testing.T) {
+	// A body that jumps about the sky never settles on a time: no event is reported.
+	position := func(jd float64) (α unit.RA, δ, π, s unit.Angle) {
+		return unit.RAFromRad(math.Mod(jd*1e4, 1) * 2 * math.Pi), 0, 0, 0
+	}
+	times := rise.Find(position, julian.CalendarGregorianToJD(2022, 2, 11), &globe.Coord{Lat: boston.Lat, Lon: -boston.Lon}, nil)
+	if times.Rise != 0 || times.Transit != 0 || times.Set != 0 {
+		t.Errorf("%+v, want no events", times)
+	}
+}
@@ -57,6 +57,17 @@ func Eccentricity(T float64) float64 {
 	return base.Horner(T, 0.016708634, -0.000042037, -0.0000001267)
 }
 
+// Radius returns the Sun-Earth distance in AU.
+//
+// Argument T is the number of Julian centuries since J2000.
+// See base.J2000Century.
+func Radius(T float64) float64 {
+	_, ν := True(T)
+	e := Eccentricity(T)
+	// (25.5) p. 164
+	return 1.000001018 * (1 - e*e) / (1 + e*ν.Cos())
+}
+
 // ApparentLongitude returns apparent longitude of the Sun referenced
 // to the true equinox of date.
 //
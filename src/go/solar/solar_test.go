@@ -40,6 +40,14 @@ func ExampleEccentricity() {
 	// 0.016711668
 }
 
+func ExampleRadius() {
+	// Example 25.a, p. 165.
+	T := base.J2000Century(julian.CalendarGregorianToJD(1992, 10, 13))
+	fmt.Printf("%.5f AU\n", solar.Radius(T))
+	// Output:
+	// 0.99766 AU
+}
+
 func ExampleApparentLongitude() {
 	// Example 25.a, p. 165.
 	T := base.J2000Century(julian.CalendarGregorianToJD(1992, 10, 13))
@@ -0,0 +1,135 @@
+package stars
+
+import (
+	unit "webeph/unit"
+)
+
+//go:generate go run gen.go -src $HIPPARCOS
+
+// Catalog holds the brightest stars and the fainter ones used in astrology, in order of brightness: 119 in all.
+// It is entered by hand, pending a run of go generate with $HIPPARCOS set to the directory of the catalogues,
+// which rewrites this file with the thousand brightest stars and the named ones; see gen.go.
+//
+// Positions are ICRS at epoch J2000.0. Proper motions and parallaxes follow the new reduction of the
+// Hipparcos data (van Leeuwen, 2007).
+var Catalog = [...]Star{
+	{"Sirius", "α CMa", 32349, unit.NewRA(6, 45, 8.917), unit.NewAngle('-', 16, 42, 58.02), -546.01, -1223.07, 379.21, -5.5, -1.46},
+	{"Canopus", "α Car", 30438, unit.NewRA(6, 23, 57.110), unit.NewAngle('-', 52, 41, 44.38), 19.93, 23.24, 10.55, 20.3, -0.74},
+	{"Arcturus", "α Boo", 69673, unit.NewRA(14, 15, 39.672), unit.NewAngle('+', 19, 10, 56.67), -1093.39, -2000.06, 88.83, -5.19, -0.05},
+	{"Rigil Kentaurus", "α1 Cen", 71683, unit.NewRA(14, 39, 36.494), unit.NewAngle('-', 60, 50, 2.37), -3679.25, 473.67, 754.81, -21.4, -0.01},
+	{"Vega", "α Lyr", 91262, unit.NewRA(18, 36, 56.336), unit.NewAngle('+', 38, 47, 1.28), 200.94, 286.23, 130.23, -13.9, 0.03},
+	{"Capella", "α Aur", 24608, unit.NewRA(5, 16, 41.359), unit.NewAngle('+', 45, 59, 52.77), 75.52, -427.11, 76.20, 29.19, 0.08},
+	{"Rigel", "β Ori", 24436, unit.NewRA(5, 14, 32.272), unit.NewAngle('-', 8, 12, 5.90), 1.31, 0.50, 3.78, 17.8, 0.13},
+	{"Procyon", "α CMi", 37279, unit.NewRA(7, 39, 18.119), unit.NewAngle('+', 5, 13, 29.96), -714.59, -1036.80, 284.56, -3.2, 0.37},
+	{"Betelgeuse", "α Ori", 27989, unit.NewRA(5, 55, 10.305), unit.NewAngle('+', 7, 24, 25.43), 27.54, 11.30, 6.55, 21.91, 0.42},
+	{"Achernar", "α Eri", 7588, unit.NewRA(1, 37, 42.846), unit.NewAngle('-', 57, 14, 12.31), 87.00, -38.24, 23.39, 16.0, 0.46},
+	{"Hadar", "β Cen", 68702, unit.NewRA(14, 3, 49.405), unit.NewAngle('-', 60, 22, 22.93), -33.27, -23.16, 8.32, 5.9, 0.61},
+	{"Altair", "α Aql", 97649, unit.NewRA(19, 50, 46.999), unit.NewAngle('+', 8, 52, 5.96), 536.23, 385.29, 194.95, -26.1, 0.76},
+	{"Acrux", "α1 Cru", 60718, unit.NewRA(12, 26, 35.896), unit.NewAngle('-', 63, 5, 56.73), -35.83, -14.86, 10.13, -11.2, 0.76},
+	{"Aldebaran", "α Tau", 21421, unit.NewRA(4, 35, 55.239), unit.NewAngle('+', 16, 30, 33.49), 63.45, -188.94, 48.94, 54.26, 0.86},
+	{"Spica", "α Vir", 65474, unit.NewRA(13, 25, 11.579), unit.NewAngle('-', 11, 9, 40.75), -42.35, -30.67, 13.06, 1.0, 0.97},
+	{"Antares", "α Sco", 80763, unit.NewRA(16, 29, 24.460), unit.NewAngle('-', 26, 25, 55.21), -12.11, -23.30, 5.89, -3.4, 1.09},
+	{"Pollux", "β Gem", 37826, unit.NewRA(7, 45, 18.950), unit.NewAngle('+', 28, 1, 34.32), -626.55, -45.80, 96.54, 3.23, 1.14},
+	{"Fomalhaut", "α PsA", 113368, unit.NewRA(22, 57, 39.046), unit.NewAngle('-', 29, 37, 20.05), 328.95, -164.67, 129.81, 6.5, 1.16},
+	{"Deneb", "α Cyg", 102098, unit.NewRA(20, 41, 25.915), unit.NewAngle('+', 45, 16, 49.22), 2.01, 1.85, 2.31, -4.9, 1.25},
+	{"Mimosa", "β Cru", 62434, unit.NewRA(12, 47, 43.269), unit.NewAngle('-', 59, 41, 19.58), -42.97, -16.18, 11.71, 15.6, 1.25},
+	{"Regulus", "α Leo", 49669, unit.NewRA(10, 8, 22.311), unit.NewAngle('+', 11, 58, 1.95), -248.73, 5.59, 41.13, 5.9, 1.40},
+	{"Adhara", "ε CMa", 33579, unit.NewRA(6, 58, 37.548), unit.NewAngle('-', 28, 58, 19.51), 3.24, 1.33, 8.05, 27.3, 1.50},
+	{"Castor", "α Gem", 36850, unit.NewRA(7, 34, 35.863), unit.NewAngle('+', 31, 53, 17.82), -191.45, -145.19, 64.12, 5.4, 1.58},
+	{"Gacrux", "γ Cru", 61084, unit.NewRA(12, 31, 9.959), unit.NewAngle('-', 57, 6, 47.57), 28.23, -265.08, 36.83, 21.0, 1.59},
+	{"Shaula", "λ Sco", 85927, unit.NewRA(17, 33, 36.520), unit.NewAngle('-', 37, 6, 13.76), -8.53, -30.80, 5.71, -3.0, 1.62},
+	{"Bellatrix", "γ Ori", 25336, unit.NewRA(5, 25, 7.863), unit.NewAngle('+', 6, 20, 58.93), -8.11, -12.88, 12.92, 18.2, 1.64},
+	{"Elnath", "β Tau", 25428, unit.NewRA(5, 26, 17.513), unit.NewAngle('+', 28, 36, 26.82), 22.76, -173.58, 24.36, 9.2, 1.65},
+	{"Miaplacidus", "β Car", 45238, unit.NewRA(9, 13, 11.977), unit.NewAngle('-', 69, 43, 1.95), -156.47, 108.95, 28.82, -5.2, 1.67},
+	{"Alnilam", "ε Ori", 26311, unit.NewRA(5, 36, 12.813), unit.NewAngle('-', 1, 12, 6.91), 1.44, -0.78, 1.65, 25.9, 1.69},
+	{"Alnair", "α Gru", 109268, unit.NewRA(22, 8, 13.985), unit.NewAngle('-', 46, 57, 39.51), 126.69, -147.47, 32.29, 11.8, 1.74},
+	{"Alnitak", "ζ Ori", 26727, unit.NewRA(5, 40, 45.527), unit.NewAngle('-', 1, 56, 33.26), 3.19, 2.03, 4.43, 18.5, 1.77},
+	{"Alioth", "ε UMa", 62956, unit.NewRA(12, 54, 1.749), unit.NewAngle('+', 55, 57, 35.36), 111.91, -8.24, 39.51, -9.3, 1.77},
+	{"Dubhe", "α UMa", 54061, unit.NewRA(11, 3, 43.672), unit.NewAngle('+', 61, 45, 3.72), -134.11, -34.70, 26.54, -9.4, 1.79},
+	{"Mirfak", "α Per", 15863, unit.NewRA(3, 24, 19.370), unit.NewAngle('+', 49, 51, 40.25), 23.75, -26.23, 6.44, -2.04, 1.79},
+	{"Wezen", "δ CMa", 34444, unit.NewRA(7, 8, 23.485), unit.NewAngle('-', 26, 23, 35.52), -3.12, 3.31, 2.03, 34.3, 1.83},
+	{"Regor", "γ2 Vel", 39953, unit.NewRA(8, 9, 31.950), unit.NewAngle('-', 47, 20, 11.71), -5.93, 9.90, 2.92, 35.0, 1.83},
+	{"Kaus Australis", "ε Sgr", 90185, unit.NewRA(18, 24, 10.318), unit.NewAngle('-', 34, 23, 4.62), -39.42, -124.20, 22.76, -15.0, 1.85},
+	{"Avior", "ε Car", 41037, unit.NewRA(8, 22, 30.836), unit.NewAngle('-', 59, 30, 34.14), -25.52, 22.72, 5.39, 11.6, 1.86},
+	{"Alkaid", "η UMa", 67301, unit.NewRA(13, 47, 32.438), unit.NewAngle('+', 49, 18, 47.76), -121.17, -14.91, 31.38, -10.9, 1.86},
+	{"Sargas", "θ Sco", 86228, unit.NewRA(17, 37, 19.129), unit.NewAngle('-', 42, 59, 52.18), 5.53, -3.28, 11.99, 1.0, 1.87},
+	{"Menkalinan", "β Aur", 28360, unit.NewRA(5, 59, 31.723), unit.NewAngle('+', 44, 56, 50.76), -56.44, -0.95, 40.21, -18.2, 1.90},
+	{"Atria", "α TrA", 82273, unit.NewRA(16, 48, 39.895), unit.NewAngle('-', 69, 1, 39.76), 17.99, -31.58, 8.35, -3.0, 1.91},
+	{"Alhena", "γ Gem", 31681, unit.NewRA(6, 37, 42.711), unit.NewAngle('+', 16, 23, 57.41), -2.04, -66.92, 29.84, -12.5, 1.93},
+	{"Alsephina", "δ Vel", 42913, unit.NewRA(8, 44, 42.226), unit.NewAngle('-', 54, 42, 31.76), 28.78, -103.08, 40.90, 2.2, 1.93},
+	{"Peacock", "α Pav", 100751, unit.NewRA(20, 25, 38.858), unit.NewAngle('-', 56, 44, 6.32), 6.90, -86.02, 18.24, 2.0, 1.94},
+	{"Mirzam", "β CMa", 30324, unit.NewRA(6, 22, 41.985), unit.NewAngle('-', 17, 57, 21.31), -3.23, -0.78, 6.62, 33.7, 1.98},
+	{"Polaris", "α UMi", 11767, unit.NewRA(2, 31, 49.095), unit.NewAngle('+', 89, 15, 50.79), 44.48, -11.85, 7.54, -17.4, 1.98},
+	{"Alphard", "α Hya", 46390, unit.NewRA(9, 27, 35.243), unit.NewAngle('-', 8, 39, 30.96), -15.23, 34.37, 18.09, -4.3, 1.99},
+	{"Hamal", "α Ari", 9884, unit.NewRA(2, 7, 10.406), unit.NewAngle('+', 23, 27, 44.70), 188.55, -148.08, 49.56, -14.2, 2.01},
+	{"Diphda", "β Cet", 3419, unit.NewRA(0, 43, 35.371), unit.NewAngle('-', 17, 59, 11.78), 232.55, 31.99, 33.86, 13.1, 2.04},
+	{"Nunki", "σ Sgr", 92855, unit.NewRA(18, 55, 15.926), unit.NewAngle('-', 26, 17, 48.20), 15.14, -53.43, 14.32, -11.2, 2.05},
+	{"Mirach", "β And", 5447, unit.NewRA(1, 9, 43.924), unit.NewAngle('+', 35, 37, 14.01), 175.90, -112.20, 16.52, 3.0, 2.05},
+	{"Alpheratz", "α And", 677, unit.NewRA(0, 8, 23.259), unit.NewAngle('+', 29, 5, 25.55), 135.68, -162.95, 33.62, -10.6, 2.06},
+	{"Kochab", "β UMi", 72607, unit.NewRA(14, 50, 42.326), unit.NewAngle('+', 74, 9, 19.81), -32.61, 11.42, 24.91, 16.96, 2.08},
+	{"Rasalhague", "α Oph", 86032, unit.NewRA(17, 34, 56.069), unit.NewAngle('+', 12, 33, 36.13), 108.07, -221.57, 67.13, 12.6, 2.08},
+	{"Saiph", "κ Ori", 27366, unit.NewRA(5, 47, 45.389), unit.NewAngle('-', 9, 40, 10.58), 1.46, -1.28, 5.04, 20.5, 2.09},
+	{"Algol", "β Per", 14576, unit.NewRA(3, 8, 10.132), unit.NewAngle('+', 40, 57, 20.33), 2.99, -1.66, 36.27, 4.0, 2.12},
+	{"Denebola", "β Leo", 57632, unit.NewRA(11, 49, 3.578), unit.NewAngle('+', 14, 34, 19.41), -497.68, -114.67, 90.91, -0.2, 2.14},
+	{"Alphecca", "α CrB", 76267, unit.NewRA(15, 34, 41.268), unit.NewAngle('+', 26, 42, 52.89), 120.27, -89.58, 43.46, 1.7, 2.22},
+	{"Mintaka", "δ Ori", 25930, unit.NewRA(5, 32, 0.400), unit.NewAngle('-', 0, 17, 56.74), 0.64, -0.69, 3.56, 16.0, 2.23},
+	{"Mizar", "ζ UMa", 65378, unit.NewRA(13, 23, 55.540), unit.NewAngle('+', 54, 55, 31.27), 119.01, -25.97, 38.01, -5.6, 2.23},
+	{"Sadr", "γ Cyg", 100453, unit.NewRA(20, 22, 13.702), unit.NewAngle('+', 40, 15, 24.04), 2.43, -0.93, 1.78, -7.5, 2.23},
+	{"Eltanin", "γ Dra", 87833, unit.NewRA(17, 56, 36.370), unit.NewAngle('+', 51, 29, 20.02), -8.48, -22.79, 21.14, -27.9, 2.23},
+	{"Schedar", "α Cas", 3179, unit.NewRA(0, 40, 30.441), unit.NewAngle('+', 56, 32, 14.39), 50.88, -32.13, 14.29, -4.31, 2.24},
+	{"Naos", "ζ Pup", 39429, unit.NewRA(8, 3, 35.047), unit.NewAngle('-', 40, 0, 11.33), -30.82, 16.77, 3.01, -24.0, 2.25},
+	{"Almach", "γ1 And", 9640, unit.NewRA(2, 3, 53.953), unit.NewAngle('+', 42, 19, 47.02), 43.08, -50.85, 9.19, -11.7, 2.26},
+	{"Caph", "β Cas", 746, unit.NewRA(0, 9, 10.685), unit.NewAngle('+', 59, 8, 59.21), 523.50, -179.77, 59.89, 11.3, 2.28},
+	{"Algieba", "γ1 Leo", 50583, unit.NewRA(10, 19, 58.355), unit.NewAngle('+', 19, 50, 29.36), 310.77, -152.88, 25.96, -36.9, 2.28},
+	{"Dschubba", "δ Sco", 78401, unit.NewRA(16, 0, 20.005), unit.NewAngle('-', 22, 37, 18.14), -8.44, -36.80, 6.64, -7.0, 2.29},
+	{"Izar", "ε Boo", 72105, unit.NewRA(14, 44, 59.217), unit.NewAngle('+', 27, 4, 27.21), -50.95, 21.07, 15.55, -16.3, 2.35},
+	{"Merak", "β UMa", 53910, unit.NewRA(11, 1, 50.476), unit.NewAngle('+', 56, 22, 56.73), 81.43, 33.49, 40.90, -12.0, 2.37},
+	{"Enif", "ε Peg", 107315, unit.NewRA(21, 44, 11.156), unit.NewAngle('+', 9, 52, 30.04), 26.92, 0.44, 4.73, 3.4, 2.39},
+	{"Ankaa", "α Phe", 2081, unit.NewRA(0, 26, 17.051), unit.NewAngle('-', 42, 18, 21.55), 233.05, -356.30, 38.50, 74.6, 2.40},
+	{"Scheat", "β Peg", 113881, unit.NewRA(23, 3, 46.458), unit.NewAngle('+', 28, 4, 58.03), 187.65, 136.93, 16.64, 7.99, 2.42},
+	{"Sabik", "η Oph", 84012, unit.NewRA(17, 10, 22.687), unit.NewAngle('-', 15, 43, 29.66), 40.13, 99.17, 36.91, -2.4, 2.43},
+	{"Alderamin", "α Cep", 105199, unit.NewRA(21, 18, 34.772), unit.NewAngle('+', 62, 35, 8.07), 150.55, 49.09, 66.50, -11.2, 2.45},
+	{"Markab", "α Peg", 113963, unit.NewRA(23, 4, 45.654), unit.NewAngle('+', 15, 12, 18.96), 60.40, -41.30, 23.36, -2.7, 2.49},
+	{"Menkar", "α Cet", 14135, unit.NewRA(3, 2, 16.773), unit.NewAngle('+', 4, 5, 23.06), -10.41, -76.85, 13.10, -26.08, 2.54},
+	{"Han", "ζ Oph", 81377, unit.NewRA(16, 37, 9.539), unit.NewAngle('-', 10, 34, 1.53), 15.26, 24.79, 8.91, -9.0, 2.56},
+	{"Zosma", "δ Leo", 54872, unit.NewRA(11, 14, 6.501), unit.NewAngle('+', 20, 31, 25.38), 143.43, -129.52, 55.82, -20.2, 2.56},
+	{"Arneb", "α Lep", 25985, unit.NewRA(5, 32, 43.816), unit.NewAngle('-', 17, 49, 20.24), 3.56, 1.18, 2.54, 24.7, 2.58},
+	{"Gienah", "γ Crv", 59803, unit.NewRA(12, 15, 48.371), unit.NewAngle('-', 17, 32, 30.95), -158.61, 21.86, 21.23, -4.2, 2.59},
+	{"Ascella", "ζ Sgr", 93506, unit.NewRA(19, 2, 36.715), unit.NewAngle('-', 29, 52, 48.38), 14.09, 1.66, 36.98, 22.0, 2.60},
+	{"Zubeneschamali", "β Lib", 74785, unit.NewRA(15, 17, 0.414), unit.NewAngle('-', 9, 22, 58.49), -95.10, -21.32, 17.66, -35.2, 2.61},
+	{"Acrab", "β1 Sco", 78820, unit.NewRA(16, 5, 26.231), unit.NewAngle('-', 19, 48, 19.63), -5.20, -24.04, 8.07, -1.0, 2.62},
+	{"Unukalhai", "α Ser", 77070, unit.NewRA(15, 44, 16.074), unit.NewAngle('+', 6, 25, 32.26), 133.84, 44.81, 44.10, 2.6, 2.63},
+	{"Sheratan", "β Ari", 8903, unit.NewRA(1, 54, 38.411), unit.NewAngle('+', 20, 48, 28.91), 98.74, -110.41, 55.60, -1.9, 2.64},
+	{"Muphrid", "η Boo", 67927, unit.NewRA(13, 54, 41.079), unit.NewAngle('+', 18, 23, 51.79), -60.95, -356.29, 88.17, 0.0, 2.68},
+	{"Lesath", "υ Sco", 85696, unit.NewRA(17, 30, 45.837), unit.NewAngle('-', 37, 17, 44.93), -4.19, -29.13, 5.71, 8.0, 2.70},
+	{"Kaus Media", "δ Sgr", 89931, unit.NewRA(18, 20, 59.644), unit.NewAngle('-', 29, 49, 41.17), 29.96, -26.38, 9.38, -20.0, 2.70},
+	{"Yed Prior", "δ Oph", 79593, unit.NewRA(16, 14, 20.739), unit.NewAngle('-', 3, 41, 39.56), -45.83, -142.91, 19.06, -19.8, 2.73},
+	{"Porrima", "γ Vir", 61941, unit.NewRA(12, 41, 39.643), unit.NewAngle('-', 1, 26, 57.74), -616.66, 60.66, 85.58, -20.0, 2.74},
+	{"Zubenelgenubi", "α2 Lib", 72622, unit.NewRA(14, 50, 52.713), unit.NewAngle('-', 16, 2, 30.40), -105.68, -68.40, 43.03, -10.0, 2.75},
+	{"Vindemiatrix", "ε Vir", 63608, unit.NewRA(13, 2, 10.597), unit.NewAngle('+', 10, 57, 32.94), -275.05, 19.96, 29.75, -14.3, 2.79},
+	{"Kaus Borealis", "λ Sgr", 90496, unit.NewRA(18, 27, 58.241), unit.NewAngle('-', 25, 25, 18.11), -44.81, -186.29, 41.69, -43.1, 2.82},
+	{"Algenib", "γ Peg", 1067, unit.NewRA(0, 13, 14.151), unit.NewAngle('+', 15, 11, 0.94), 1.98, -9.28, 8.33, 4.1, 2.83},
+	{"Deneb Algedi", "δ Cap", 107556, unit.NewRA(21, 47, 2.444), unit.NewAngle('-', 16, 7, 38.23), 261.75, -296.23, 84.58, -6.3, 2.85},
+	{"Alcyone", "η Tau", 17702, unit.NewRA(3, 47, 29.077), unit.NewAngle('+', 24, 6, 18.49), 19.34, -43.67, 8.09, 5.4, 2.87},
+	{"Sadalsuud", "β Aqr", 106278, unit.NewRA(21, 31, 33.532), unit.NewAngle('-', 5, 34, 16.23), 18.77, -8.21, 5.33, 6.5, 2.87},
+	{"Cor Caroli", "α2 CVn", 63125, unit.NewRA(12, 56, 1.667), unit.NewAngle('+', 38, 19, 6.15), -235.08, 53.54, 28.57, -3.3, 2.88},
+	{"Tejat", "μ Gem", 30343, unit.NewRA(6, 22, 57.627), unit.NewAngle('+', 22, 30, 48.90), 56.86, -110.38, 14.13, 55.0, 2.88},
+	{"Algorab", "δ Crv", 60965, unit.NewRA(12, 29, 51.855), unit.NewAngle('-', 16, 30, 55.56), -210.50, -138.66, 37.55, 9.0, 2.94},
+	{"Sadalmelik", "α Aqr", 109074, unit.NewRA(22, 5, 47.036), unit.NewAngle('-', 0, 19, 11.46), 17.90, -9.93, 6.23, 7.5, 2.94},
+	{"Dabih", "β1 Cap", 100345, unit.NewRA(20, 21, 0.676), unit.NewAngle('-', 14, 46, 52.98), 49.09, -1.18, 9.50, -18.9, 3.05},
+	{"Albireo", "β1 Cyg", 95947, unit.NewRA(19, 30, 43.281), unit.NewAngle('+', 27, 57, 34.85), -7.09, -6.15, 7.51, -24.0, 3.08},
+	{"Skat", "δ Aqr", 113136, unit.NewRA(22, 54, 39.012), unit.NewAngle('-', 15, 49, 14.95), -42.77, -27.57, 20.44, 18.0, 3.27},
+	{"Rasalgethi", "α1 Her", 84345, unit.NewRA(17, 14, 38.858), unit.NewAngle('+', 14, 23, 25.23), -7.32, 36.07, 9.07, -33.1, 3.48},
+	{"Wasat", "δ Gem", 35550, unit.NewRA(7, 20, 7.380), unit.NewAngle('+', 21, 58, 56.35), -18.72, -7.99, 55.45, 4.1, 3.53},
+	{"Ain", "ε Tau", 20889, unit.NewRA(4, 28, 36.999), unit.NewAngle('+', 19, 10, 49.55), 107.23, -37.84, 22.24, 38.7, 3.53},
+	{"Algedi", "α2 Cap", 100064, unit.NewRA(20, 18, 3.256), unit.NewAngle('-', 12, 32, 41.47), 61.16, 3.55, 30.04, 0.1, 3.57},
+	{"Zavijava", "β Vir", 57757, unit.NewRA(11, 50, 41.718), unit.NewAngle('+', 1, 45, 52.99), 740.23, -270.43, 91.50, 4.4, 3.60},
+	{"Prima Hyadum", "γ Tau", 20205, unit.NewRA(4, 19, 47.604), unit.NewAngle('+', 15, 37, 39.51), 114.25, -23.16, 20.66, 38.6, 3.65},
+	{"Nashira", "γ Cap", 106985, unit.NewRA(21, 40, 5.456), unit.NewAngle('-', 16, 39, 44.31), 188.67, -23.81, 23.55, -31.1, 3.69},
+	{"Sualocin", "α Del", 101958, unit.NewRA(20, 39, 38.287), unit.NewAngle('+', 15, 54, 43.46), 53.84, 8.34, 12.85, -3.0, 3.77},
+	{"Alrescha", "α Psc", 9487, unit.NewRA(2, 2, 2.816), unit.NewAngle('+', 2, 45, 49.54), 31.71, -0.41, 19.34, 9.0, 3.82},
+	{"Asellus Australis", "δ Cnc", 42911, unit.NewRA(8, 44, 41.100), unit.NewAngle('+', 18, 9, 15.51), -17.67, -228.46, 23.97, 17.1, 3.94},
+	{"Alkes", "α Crt", 53740, unit.NewRA(10, 59, 46.465), unit.NewAngle('-', 18, 17, 55.62), -462.59, 129.20, 19.79, 46.7, 4.08},
+	{"Acubens", "α Cnc", 44066, unit.NewRA(8, 58, 29.222), unit.NewAngle('+', 11, 51, 27.72), 41.44, -29.35, 18.79, -13.8, 4.26},
+	{"Asellus Borealis", "γ Cnc", 42806, unit.NewRA(8, 43, 17.146), unit.NewAngle('+', 21, 28, 6.60), -106.40, -39.06, 18.14, 28.2, 4.66},
+}
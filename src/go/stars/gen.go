@@ -0,0 +1,51 @@
+//go:build ignore
+
+// Gen writes catalog.go from the Hipparcos catalogues, and the rows it used to testdata/hipparcos.
+//
+// Usage, from this directory:
+//	go run gen.go -src dir
+// where dir holds the catalogues as CDS lays them out: dir/I/311, dir/I/239 and dir/V/50, each with its ReadMe.
+// go generate takes dir from $HIPPARCOS.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	hipparcos "webeph/stars/hipparcos"
+)
+
+func main() {
+	src := flag.String("src", "", "the directory of the catalogues")
+	flag.Parse()
+	if *src == "" {
+		log.Fatal("gen: -src is required")
+	}
+	catalogues, err := hipparcos.Read(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	all, err := catalogues.Stars()
+	if err != nil {
+		log.Fatal(err)
+	}
+	catalog := hipparcos.Select(all, hipparcos.Count)
+	var b bytes.Buffer
+	if err = hipparcos.Write(&b, catalog); err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile("catalog.go", b.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+	extract := filepath.Join("testdata", "hipparcos")
+	if err = os.RemoveAll(extract); err != nil {
+		log.Fatal(err)
+	}
+	if err = catalogues.Extract(extract, catalog); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("gen: %d stars", len(catalog))
+}
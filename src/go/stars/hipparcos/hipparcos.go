@@ -0,0 +1,380 @@
+// Hipparcos: reads the Hipparcos catalogues and the Bright Star Catalogue, from which stars.Catalog is generated.
+//
+// Each catalogue is read as CDS distributes it: a ReadMe, and fixed-width data files, plain or gzipped.  The
+// columns are taken from the byte-by-byte description in the ReadMe, so no byte position is written here.
+// Three catalogues are used, each in the directory of its CDS path:
+//	I/311, the new reduction (van Leeuwen, 2007): hip2.dat, for positions, proper motions and parallaxes
+//	I/239, the original catalogue: hip_main.dat, for visual magnitudes and HD numbers
+//	V/50, the Bright Star Catalogue: catalog, for Bayer designations and radial velocities, matched by HD number
+package hipparcos
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	base "webeph/base"
+	stars "webeph/stars"
+	unit "webeph/unit"
+)
+
+// Count is the number of the brightest stars in the catalog, besides the fainter ones in Names.
+const Count = 1000
+
+// Name is the name of a star.
+type Name struct {
+	Name  string // proper name
+	Bayer string // Bayer designation, as in stars.Star
+}
+
+// A catalogue file.
+type file struct {
+	path string // the CDS path of the catalogue
+	name string // the data file, as named in the ReadMe
+}
+
+var (
+	newReduction = file{"I/311", "hip2.dat"}
+	original     = file{"I/239", "hip_main.dat"}
+	brightStars  = file{"V/50", "catalog"}
+)
+
+// The epoch of the Hipparcos positions, J1991.25, in Julian years from J2000.0.
+const epoch = -8.75
+
+// A column: the bytes it takes, counted from 1.
+type column struct {
+	first, last int
+}
+
+// table is a data file with the columns of its ReadMe.
+type table struct {
+	file    file
+	readMe  []byte
+	columns map[string]column
+	lines   []string
+}
+
+// Source holds the catalogues.
+type Source struct {
+	newReduction, original, brightStars *table
+}
+
+// A row of the byte-by-byte description: bytes, format, units, label.
+var byteByByte = regexp.MustCompile(`^\s*(\d+)(?:\s*-\s*(\d+))?\s+[AIFE][0-9.]+\s+\S+\s+(\S+)`)
+
+// Reads the catalogues.
+// Receives:
+//	dir: the directory holding each catalogue in the directory of its CDS path, such as dir/I/311
+// Returns:
+//	src: the catalogues
+//	err: any errors encountered, wrapping fs.ErrNotExist for a missing file
+func Read(dir string) (src *Source, err error) {
+	src = &Source{}
+	for _, t := range []struct {
+		f   file
+		dst **table
+	}{{newReduction, &src.newReduction}, {original, &src.original}, {brightStars, &src.brightStars}} {
+		if *t.dst, err = readTable(dir, t.f); err != nil {
+			return nil, err
+		}
+	}
+	return src, nil
+}
+
+// Reads a data file and the columns its ReadMe describes.
+// Receives:
+//	dir: the directory of the catalogues
+//	f: the file
+// Returns:
+//	t: the file
+//	err: any errors encountered
+func readTable(dir string, f file) (t *table, err error) {
+	t = &table{file: f, columns: map[string]column{}}
+	path := filepath.Join(dir, filepath.FromSlash(f.path))
+	if t.readMe, err = os.ReadFile(filepath.Join(path, "ReadMe")); err != nil {
+		return nil, err
+	}
+	// The description of the file runs from its heading to the rule after its last row.
+	in := false
+	for _, line := range strings.Split(string(t.readMe), "\n") {
+		if strings.HasPrefix(line, "Byte-by-byte Description of file:") {
+			if in {
+				break
+			}
+			in = false
+			for _, name := range strings.FieldsFunc(line[len("Byte-by-byte Description of file:"):], isSeparator) {
+				in = in || name == f.name
+			}
+			continue
+		}
+		if !in {
+			continue
+		}
+		if strings.HasPrefix(line, "----") && len(t.columns) > 0 {
+			break
+		}
+		if m := byteByByte.FindStringSubmatch(line); m != nil {
+			first, _ := strconv.Atoi(m[1])
+			last := first
+			if m[2] != "" {
+				last, _ = strconv.Atoi(m[2])
+			}
+			t.columns[m[3]] = column{first, last}
+		}
+	}
+	if len(t.columns) == 0 {
+		return nil, fmt.Errorf("%s/ReadMe does not describe %s", f.path, f.name)
+	}
+	r, err := openData(filepath.Join(path, f.name))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		t.lines = append(t.lines, scanner.Text())
+	}
+	return t, scanner.Err()
+}
+
+// Separates the names of the files a description covers.
+func isSeparator(r rune) bool {
+	return r == ' ' || r == ','
+}
+
+// Opens a data file, or its gzipped copy.
+func openData(name string) (io.ReadCloser, error) {
+	if f, err := os.Open(name); err == nil || !errors.Is(err, os.ErrNotExist) {
+		return f, err
+	}
+	f, err := os.Open(name + ".gz")
+	if err != nil {
+		return nil, err
+	}
+	z, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{z, f}, nil
+}
+
+// Finds a field of a line.
+// Receives:
+//	line: a line of the data file
+//	label: the label of the column, as in the ReadMe
+// Returns:
+//	the field, with blanks trimmed; empty if the line stops short of it
+func (t *table) field(line, label string) string {
+	return strings.TrimSpace(t.cell(line, label))
+}
+
+// Finds a field of a line, blanks and all.
+// Receives:
+//	line: a line of the data file
+//	label: the label of the column, as in the ReadMe
+// Returns:
+//	the field, padded with blanks to its width
+func (t *table) cell(line, label string) string {
+	c, ok := t.columns[label]
+	if !ok {
+		return ""
+	}
+	line = fmt.Sprintf("%-*s", c.last, line)
+	return line[c.first-1 : c.last]
+}
+
+// Finds a number in a field of a line.
+// Receives:
+//	line: a line of the data file
+//	label: the label of the column
+// Returns:
+//	x: the number
+//	ok: false if the field is blank or not a number
+func (t *table) number(line, label string) (x float64, ok bool) {
+	x, err := strconv.ParseFloat(t.field(line, label), 64)
+	return x, err == nil
+}
+
+// Checks that a table has the columns needed.
+func (t *table) need(labels ...string) error {
+	for _, label := range labels {
+		if _, ok := t.columns[label]; !ok {
+			return fmt.Errorf("%s/ReadMe has no column %s for %s", t.file.path, label, t.file.name)
+		}
+	}
+	return nil
+}
+
+// Lists every Hipparcos star with a visual magnitude.
+// Receives:
+//	nothing
+// Returns:
+//	all: the stars, in order of HIP number, with positions carried to J2000.0
+//	err: any errors encountered
+// Notes:
+//	A star takes its name from Names, or else goes by its Bayer or Flamsteed designation in the Bright Star Catalogue,
+//	or else its HIP number. A star not in the Bright Star Catalogue has no radial velocity, and is taken as at rest.
+func (src *Source) Stars() (all []stars.Star, err error) {
+	if err = src.newReduction.need("HIP", "RArad", "DErad", "Plx", "pmRA", "pmDE"); err != nil {
+		return
+	}
+	if err = src.original.need("HIP", "Vmag", "HD"); err != nil {
+		return
+	}
+	if err = src.brightStars.need("Name", "HD", "RadVel"); err != nil {
+		return
+	}
+	type bright struct {
+		name string
+		rv   float64
+	}
+	byHD := map[string]bright{}
+	for _, line := range src.brightStars.lines {
+		if hd := src.brightStars.field(line, "HD"); hd != "" {
+			if _, ok := byHD[hd]; !ok {
+				rv, _ := src.brightStars.number(line, "RadVel")
+				byHD[hd] = bright{src.brightStars.cell(line, "Name"), rv}
+			}
+		}
+	}
+	type visual struct {
+		mag float64
+		hd  string
+	}
+	byHIP := map[string]visual{}
+	for _, line := range src.original.lines {
+		if mag, ok := src.original.number(line, "Vmag"); ok {
+			byHIP[src.original.field(line, "HIP")] = visual{mag, src.original.field(line, "HD")}
+		}
+	}
+	t := src.newReduction
+	for _, line := range t.lines {
+		v, ok := byHIP[t.field(line, "HIP")]
+		if !ok {
+			continue
+		}
+		var s stars.Star
+		var α, δ float64
+		fields := []struct {
+			label string
+			x     *float64
+		}{{"RArad", &α}, {"DErad", &δ}, {"Plx", &s.Parallax}, {"pmRA", &s.PMRA}, {"pmDE", &s.PMDec}}
+		for _, f := range fields {
+			if *f.x, ok = t.number(line, f.label); !ok {
+				return nil, fmt.Errorf("%s: bad %s in %q", t.file.name, f.label, line)
+			}
+		}
+		if s.HIP, err = strconv.Atoi(t.field(line, "HIP")); err != nil {
+			return
+		}
+		s.Mag = v.mag
+		var flamsteed string
+		if b, ok := byHD[v.hd]; ok && v.hd != "" {
+			s.RV = b.rv
+			s.Bayer, flamsteed = designations(b.name)
+		}
+		s.RA, s.Dec = toJ2000(α, δ, s)
+		s.Name = s.Bayer
+		if n, ok := Names[s.HIP]; ok {
+			s.Name, s.Bayer = n.Name, n.Bayer
+		}
+		if s.Name == "" {
+			s.Name = flamsteed
+		}
+		if s.Name == "" {
+			s.Name = fmt.Sprintf("HIP %d", s.HIP)
+		}
+		all = append(all, s)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].HIP < all[j].HIP })
+	return all, nil
+}
+
+// Carries a Hipparcos position from J1991.25 to J2000.0.
+// Receives:
+//	α: the right ascension at J1991.25, in radians
+//	δ: the declination at J1991.25, in radians
+//	s: the star, for its motion
+// Returns:
+//	the right ascension and declination at J2000.0
+// Notes:
+//	Along the straight line of stars.Star.Propagate, which counts time from the epoch of the position.
+func toJ2000(α, δ float64, s stars.Star) (unit.RA, unit.Angle) {
+	s.RA, s.Dec = unit.RAFromRad(α), unit.Angle(δ)
+	r := s.Propagate(base.J2000 - epoch*base.JulianYear)
+	return unit.RAFromRad(math.Atan2(r[1], r[0])), unit.Angle(math.Atan2(r[2], math.Hypot(r[0], r[1])))
+}
+
+// Greek letters, with the abbreviations of the Bright Star Catalogue.
+var greek = map[string]string{
+	"Alp": "α", "Bet": "β", "Gam": "γ", "Del": "δ", "Eps": "ε", "Zet": "ζ", "Eta": "η", "The": "θ",
+	"Iot": "ι", "Kap": "κ", "Lam": "λ", "Mu": "μ", "Nu": "ν", "Xi": "ξ", "Omi": "ο", "Pi": "π",
+	"Rho": "ρ", "Sig": "σ", "Tau": "τ", "Ups": "υ", "Phi": "φ", "Chi": "χ", "Psi": "ψ", "Ome": "ω",
+}
+
+// Reads the designations in the Name field of the Bright Star Catalogue.
+// Receives:
+//	name: the field: three places for the Flamsteed number, three for the Greek letter, one for the component,
+//	 and three for the constellation, as "  9Alp CMa" or "   Alp1Cen"
+// Returns:
+//	bayer: the Bayer designation, as "α1 Cen"; empty if none
+//	flamsteed: the Flamsteed designation, as "9 CMa"; empty if none
+func designations(name string) (bayer, flamsteed string) {
+	if len(name) < 10 {
+		return "", ""
+	}
+	number := strings.TrimSpace(name[:3])
+	letter := strings.TrimSpace(name[3:6])
+	component := strings.TrimSpace(name[6:7])
+	constellation := strings.TrimSpace(name[7:10])
+	if constellation == "" {
+		return "", ""
+	}
+	if g, ok := greek[letter]; ok {
+		bayer = g + component + " " + constellation
+	}
+	if number != "" {
+		flamsteed = number + " " + constellation
+	}
+	return
+}
+
+// Chooses the stars of the catalog.
+// Receives:
+//	all: the stars to choose from
+//	n: the number of the brightest to take
+// Returns:
+//	the n brightest stars and those in Names, in order of brightness, then of HIP number
+// Notes:
+//	A name already taken by a brighter star gives way to the HIP number.
+func Select(all []stars.Star, n int) []stars.Star {
+	chosen := append([]stars.Star(nil), all...)
+	sort.SliceStable(chosen, func(i, j int) bool { return chosen[i].Mag < chosen[j].Mag })
+	kept := chosen[:0]
+	taken := map[string]bool{}
+	for i, s := range chosen {
+		if _, named := Names[s.HIP]; i >= n && !named {
+			continue
+		}
+		if taken[s.Name] {
+			s.Name = fmt.Sprintf("HIP %d", s.HIP)
+		}
+		taken[s.Name] = true
+		kept = append(kept, s)
+	}
+	return kept
+}
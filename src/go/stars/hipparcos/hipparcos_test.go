@@ -0,0 +1,135 @@
+package hipparcos_test
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+
+	stars "webeph/stars"
+	hipparcos "webeph/stars/hipparcos"
+	testutils "webeph/testutils"
+)
+
+// testdata holds a few rows laid out as in the catalogues, with the Bright Star Catalogue gzipped.
+func read(t *testing.T) []stars.Star {
+	src, err := hipparcos.Read("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := src.Stars()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return all
+}
+
+func TestStars(t *testing.T) {
+	all := read(t)
+	want := []struct {
+		hip         int
+		name, bayer string
+		rv, mag     float64
+	}{
+		{10, "α1 Cen", "α1 Cen", -22, .5},
+		{20, "58 Ori", "", 21, 1},
+		{30, "HIP 30", "", 0, 2},
+		{50, "α1 Cen", "α1 Cen", 0, 3},
+		{32349, "Sirius", "α CMa", -8, -1.44},
+		{42806, "Asellus Borealis", "γ Cnc", 29, 4.66},
+	}
+	// HIP 40 has no visual magnitude.
+	if len(all) != len(want) {
+		t.Fatalf("%d stars, want %d", len(all), len(want))
+	}
+	for i, w := range want {
+		s := all[i]
+		if s.HIP != w.hip || s.Name != w.name || s.Bayer != w.bayer || s.RV != w.rv || s.Mag != w.mag {
+			t.Errorf("star %d: %d %q %q %v %v, want %v", i, s.HIP, s.Name, s.Bayer, s.RV, s.Mag, w)
+		}
+	}
+	// HIP 10 starts at 0h 0°, and moves east by 1″ a year for the 8.75 years from J1991.25.
+	if !testutils.CheckTolerance(all[0].RA.Angle().Sec(), 8.75, .001) {
+		t.Errorf("RA %v″, want 8.75″", all[0].RA.Angle().Sec())
+	}
+	if all[0].Dec != 0 {
+		t.Errorf("Dec %v, want 0", all[0].Dec)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	all := read(t)
+	var names []string
+	for _, s := range hipparcos.Select(all, 2) {
+		names = append(names, s.Name)
+	}
+	if got, want := strings.Join(names, ", "), "Sirius, α1 Cen, Asellus Borealis"; got != want {
+		t.Errorf("the 2 brightest and the named: %s, want %s", got, want)
+	}
+	names = nil
+	for _, s := range hipparcos.Select(all, 10) {
+		names = append(names, s.Name)
+	}
+	if got, want := strings.Join(names, ", "), "Sirius, α1 Cen, 58 Ori, HIP 30, HIP 50, Asellus Borealis"; got != want {
+		t.Errorf("all: %s, want %s", got, want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	catalog := hipparcos.Select(read(t), 10)
+	var b bytes.Buffer
+	if err := hipparcos.Write(&b, catalog); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"// Code generated by gen.go from the Hipparcos catalogues; DO NOT EDIT.\n",
+		"//go:generate go run gen.go -src $HIPPARCOS\n",
+		"in order of brightness: 6 in all.\n",
+		"\t{\"α1 Cen\", \"α1 Cen\", 10, unit.NewRA(0, 0, 0.583), unit.NewAngle('+', 0, 0, 0.00), 1000.00, 0.00, 100.00, -22.0, 0.50},\n",
+		"\t{\"58 Ori\", \"\", 20, unit.NewRA(3, 49, 10.987), unit.NewAngle('+', 28, 38, 52.40), 0.00, 0.00, 5.00, 21.0, 1.00},\n",
+	} {
+		if !strings.Contains(b.String(), line) {
+			t.Errorf("missing %q", line)
+		}
+	}
+}
+
+func TestExtract(t *testing.T) {
+	src, err := hipparcos.Read("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := src.Stars()
+	if err != nil {
+		t.Fatal(err)
+	}
+	catalog := hipparcos.Select(all, 2)
+	dir := t.TempDir()
+	if err = src.Extract(dir, catalog); err != nil {
+		t.Fatal(err)
+	}
+	extract, err := hipparcos.Read(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := extract.Stars()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want, got bytes.Buffer
+	hipparcos.Write(&want, catalog)
+	hipparcos.Write(&got, hipparcos.Select(rows, 2))
+	if got.String() != want.String() {
+		t.Errorf("the extract gives\n%s\nwant\n%s", got.String(), want.String())
+	}
+	if len(rows) != len(catalog) {
+		t.Errorf("%d rows extracted, want %d", len(rows), len(catalog))
+	}
+}
+
+func TestMissing(t *testing.T) {
+	if _, err := hipparcos.Read(t.TempDir()); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("error %v, want fs.ErrNotExist", err)
+	}
+}
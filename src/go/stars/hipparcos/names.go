@@ -0,0 +1,125 @@
+package hipparcos
+
+// Names holds the proper names and Bayer designations of the named stars, by HIP number. The designations here are kept
+// over those of the Bright Star Catalogue, and a named star is always in the catalog, however faint.
+var Names = map[int]Name{
+	32349:  {"Sirius", "α CMa"},
+	30438:  {"Canopus", "α Car"},
+	69673:  {"Arcturus", "α Boo"},
+	71683:  {"Rigil Kentaurus", "α1 Cen"},
+	91262:  {"Vega", "α Lyr"},
+	24608:  {"Capella", "α Aur"},
+	24436:  {"Rigel", "β Ori"},
+	37279:  {"Procyon", "α CMi"},
+	27989:  {"Betelgeuse", "α Ori"},
+	7588:   {"Achernar", "α Eri"},
+	68702:  {"Hadar", "β Cen"},
+	97649:  {"Altair", "α Aql"},
+	60718:  {"Acrux", "α1 Cru"},
+	21421:  {"Aldebaran", "α Tau"},
+	65474:  {"Spica", "α Vir"},
+	80763:  {"Antares", "α Sco"},
+	37826:  {"Pollux", "β Gem"},
+	113368: {"Fomalhaut", "α PsA"},
+	102098: {"Deneb", "α Cyg"},
+	62434:  {"Mimosa", "β Cru"},
+	49669:  {"Regulus", "α Leo"},
+	33579:  {"Adhara", "ε CMa"},
+	36850:  {"Castor", "α Gem"},
+	61084:  {"Gacrux", "γ Cru"},
+	85927:  {"Shaula", "λ Sco"},
+	25336:  {"Bellatrix", "γ Ori"},
+	25428:  {"Elnath", "β Tau"},
+	45238:  {"Miaplacidus", "β Car"},
+	26311:  {"Alnilam", "ε Ori"},
+	109268: {"Alnair", "α Gru"},
+	26727:  {"Alnitak", "ζ Ori"},
+	62956:  {"Alioth", "ε UMa"},
+	54061:  {"Dubhe", "α UMa"},
+	15863:  {"Mirfak", "α Per"},
+	34444:  {"Wezen", "δ CMa"},
+	39953:  {"Regor", "γ2 Vel"},
+	90185:  {"Kaus Australis", "ε Sgr"},
+	41037:  {"Avior", "ε Car"},
+	67301:  {"Alkaid", "η UMa"},
+	86228:  {"Sargas", "θ Sco"},
+	28360:  {"Menkalinan", "β Aur"},
+	82273:  {"Atria", "α TrA"},
+	31681:  {"Alhena", "γ Gem"},
+	42913:  {"Alsephina", "δ Vel"},
+	100751: {"Peacock", "α Pav"},
+	30324:  {"Mirzam", "β CMa"},
+	11767:  {"Polaris", "α UMi"},
+	46390:  {"Alphard", "α Hya"},
+	9884:   {"Hamal", "α Ari"},
+	3419:   {"Diphda", "β Cet"},
+	92855:  {"Nunki", "σ Sgr"},
+	5447:   {"Mirach", "β And"},
+	677:    {"Alpheratz", "α And"},
+	72607:  {"Kochab", "β UMi"},
+	86032:  {"Rasalhague", "α Oph"},
+	27366:  {"Saiph", "κ Ori"},
+	14576:  {"Algol", "β Per"},
+	57632:  {"Denebola", "β Leo"},
+	76267:  {"Alphecca", "α CrB"},
+	25930:  {"Mintaka", "δ Ori"},
+	65378:  {"Mizar", "ζ UMa"},
+	100453: {"Sadr", "γ Cyg"},
+	87833:  {"Eltanin", "γ Dra"},
+	3179:   {"Schedar", "α Cas"},
+	39429:  {"Naos", "ζ Pup"},
+	9640:   {"Almach", "γ1 And"},
+	746:    {"Caph", "β Cas"},
+	50583:  {"Algieba", "γ1 Leo"},
+	78401:  {"Dschubba", "δ Sco"},
+	72105:  {"Izar", "ε Boo"},
+	53910:  {"Merak", "β UMa"},
+	107315: {"Enif", "ε Peg"},
+	2081:   {"Ankaa", "α Phe"},
+	113881: {"Scheat", "β Peg"},
+	84012:  {"Sabik", "η Oph"},
+	105199: {"Alderamin", "α Cep"},
+	113963: {"Markab", "α Peg"},
+	14135:  {"Menkar", "α Cet"},
+	81377:  {"Han", "ζ Oph"},
+	54872:  {"Zosma", "δ Leo"},
+	25985:  {"Arneb", "α Lep"},
+	59803:  {"Gienah", "γ Crv"},
+	93506:  {"Ascella", "ζ Sgr"},
+	74785:  {"Zubeneschamali", "β Lib"},
+	78820:  {"Acrab", "β1 Sco"},
+	77070:  {"Unukalhai", "α Ser"},
+	8903:   {"Sheratan", "β Ari"},
+	67927:  {"Muphrid", "η Boo"},
+	85696:  {"Lesath", "υ Sco"},
+	89931:  {"Kaus Media", "δ Sgr"},
+	79593:  {"Yed Prior", "δ Oph"},
+	61941:  {"Porrima", "γ Vir"},
+	72622:  {"Zubenelgenubi", "α2 Lib"},
+	63608:  {"Vindemiatrix", "ε Vir"},
+	90496:  {"Kaus Borealis", "λ Sgr"},
+	1067:   {"Algenib", "γ Peg"},
+	107556: {"Deneb Algedi", "δ Cap"},
+	17702:  {"Alcyone", "η Tau"},
+	106278: {"Sadalsuud", "β Aqr"},
+	63125:  {"Cor Caroli", "α2 CVn"},
+	30343:  {"Tejat", "μ Gem"},
+	60965:  {"Algorab", "δ Crv"},
+	109074: {"Sadalmelik", "α Aqr"},
+	100345: {"Dabih", "β1 Cap"},
+	95947:  {"Albireo", "β1 Cyg"},
+	113136: {"Skat", "δ Aqr"},
+	84345:  {"Rasalgethi", "α1 Her"},
+	35550:  {"Wasat", "δ Gem"},
+	20889:  {"Ain", "ε Tau"},
+	100064: {"Algedi", "α2 Cap"},
+	57757:  {"Zavijava", "β Vir"},
+	20205:  {"Prima Hyadum", "γ Tau"},
+	106985: {"Nashira", "γ Cap"},
+	101958: {"Sualocin", "α Del"},
+	9487:   {"Alrescha", "α Psc"},
+	42911:  {"Asellus Australis", "δ Cnc"},
+	53740:  {"Alkes", "α Crt"},
+	44066:  {"Acubens", "α Cnc"},
+	42806:  {"Asellus Borealis", "γ Cnc"},
+}
@@ -0,0 +1,124 @@
+package hipparcos
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	stars "webeph/stars"
+)
+
+// The head of catalog.go, up to the first star.
+const head = `// Code generated by gen.go from the Hipparcos catalogues; DO NOT EDIT.
+
+package stars
+
+import (
+	unit "webeph/unit"
+)
+
+//go:generate go run gen.go -src $HIPPARCOS
+
+// Catalog holds the %d brightest stars and the fainter ones named in astrology, in order of brightness: %d in all.
+//
+// Positions are ICRS, carried from epoch J1991.25 to J2000.0 along the space motion. Proper motions and parallaxes
+// follow the new reduction of the Hipparcos data (van Leeuwen, 2007), visual magnitudes the original catalogue, and
+// Bayer designations and radial velocities the Bright Star Catalogue. A star without a proper name goes by its Bayer
+// or Flamsteed designation, or its HIP number.
+var Catalog = [...]Star{
+`
+
+// Writes the source of catalog.go.
+// Receives:
+//	w: the destination
+//	catalog: the stars, from Select
+// Returns:
+//	any errors encountered
+func Write(w io.Writer, catalog []stars.Star) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, head, Count, len(catalog))
+	for _, s := range catalog {
+		h, m, sec := split(s.RA.Hour(), 3)
+		sign := '+'
+		if s.Dec < 0 {
+			sign = '-'
+		}
+		d, dm, ds := split(math.Abs(s.Dec.Deg()), 2)
+		fmt.Fprintf(b, "\t{%q, %q, %d, unit.NewRA(%d, %d, %s), unit.NewAngle('%c', %d, %d, %s), %s, %s, %s, %s, %s},\n",
+			s.Name, s.Bayer, s.HIP, h, m, sec, sign, d, dm, ds,
+			decimal(s.PMRA, 2), decimal(s.PMDec, 2), decimal(s.Parallax, 2), decimal(s.RV, 1), decimal(s.Mag, 2))
+	}
+	b.WriteString("}\n")
+	return b.Flush()
+}
+
+// Splits hours or degrees into sexagesimal parts.
+// Receives:
+//	x: the hours or degrees, not negative
+//	places: the decimal places of the seconds
+// Returns:
+//	the whole hours or degrees, the whole minutes, and the seconds, rounded with the carry taken up
+func split(x float64, places int) (int, int, string) {
+	scale := math.Pow(10, float64(places))
+	n := int64(math.Round(x * 3600 * scale))
+	perMinute := int64(60 * scale)
+	return int(n / (60 * perMinute)), int(n / perMinute % 60), strconv.FormatFloat(float64(n%perMinute)/scale, 'f', places, 64)
+}
+
+// Writes a number to a number of decimal places, without a negative zero.
+func decimal(x float64, places int) string {
+	s := strconv.FormatFloat(x, 'f', places, 64)
+	if strings.Trim(s, "-0.") == "" {
+		return strings.TrimPrefix(s, "-")
+	}
+	return s
+}
+
+// Writes the rows of the catalogues that a catalog was built from.
+// Receives:
+//	dir: the destination, laid out as the directory given to Read
+//	catalog: the stars, from Select
+// Returns:
+//	any errors encountered
+// Notes:
+//	Each ReadMe is copied whole. Read on dir then finds the same stars, so the catalog can be checked against its source.
+func (src *Source) Extract(dir string, catalog []stars.Star) error {
+	hips := map[string]bool{}
+	for _, s := range catalog {
+		hips[strconv.Itoa(s.HIP)] = true
+	}
+	hds := map[string]bool{}
+	for _, line := range src.original.lines {
+		if hips[src.original.field(line, "HIP")] {
+			hds[src.original.field(line, "HD")] = true
+		}
+	}
+	for _, t := range []struct {
+		t     *table
+		label string
+		keep  map[string]bool
+	}{{src.newReduction, "HIP", hips}, {src.original, "HIP", hips}, {src.brightStars, "HD", hds}} {
+		path := filepath.Join(dir, filepath.FromSlash(t.t.file.path))
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(path, "ReadMe"), t.t.readMe, 0644); err != nil {
+			return err
+		}
+		var rows strings.Builder
+		for _, line := range t.t.lines {
+			if key := t.t.field(line, t.label); key != "" && t.keep[key] {
+				rows.WriteString(line + "\n")
+			}
+		}
+		if err := os.WriteFile(filepath.Join(path, t.t.file.name), []byte(rows.String()), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package stars
+
+import (
+	"math"
+
+	base "webeph/base"
+	deltat "webeph/deltat"
+	nutation "webeph/nutation"
+	pp "webeph/planetposition"
+	unit "webeph/unit"
+)
+
+const (
+	// the astronomical unit, in km
+	au = 149597870.7
+	// the speed of light, in AU per day
+	c = 299792.458 * 86400 / au
+	// the parsec, in AU
+	parsec = 648000 / math.Pi
+	// The least parallax used, in milliarcseconds. Catalog parallaxes of distant stars can be zero or negative;
+	// at a megaparsec, parallax and radial velocity fall out, and proper motion goes on as cataloged.
+	minParallax = 1e-3
+)
+
+// Place is the apparent place of a star.
+type Place struct {
+	RA  unit.RA    // right ascension, referred to the true equator and equinox of date
+	Dec unit.Angle // declination, referred to the true equator and equinox of date
+	Lon unit.Angle // ecliptic longitude, referred to the true ecliptic and equinox of date
+	Lat unit.Angle // ecliptic latitude, referred to the true ecliptic and equinox of date
+}
+
+// Finds the barycentric position of a star, carried along its space motion from J2000.0.
+// Receives:
+//	jde: the Julian ephemeris day
+// Returns:
+//	r: the position, in AU, referred to the mean equator and equinox of J2000.0
+// Notes:
+//	The star moves in a straight line at its cataloged velocity: proper motion across the line of sight,
+//	radial velocity along it. Over millennia the proper motion of a near star grows or shrinks as its distance
+//	changes, which a proper motion in RA and Dec alone misses.
+func (s *Star) Propagate(jde float64) (r [3]float64) {
+	plx := math.Max(s.Parallax, minParallax)
+	d := parsec / (plx / 1000)
+	sα, cα := s.RA.Sincos()
+	sδ, cδ := s.Dec.Sincos()
+	// the line of sight, and the directions of increasing RA and Dec
+	p := [3]float64{cδ * cα, cδ * sα, sδ}
+	eα := [3]float64{-sα, cα, 0}
+	eδ := [3]float64{-sδ * cα, -sδ * sα, cδ}
+	// proper motion in radians per year times distance, and radial velocity, all in AU per year
+	μα := unit.AngleFromSec(s.PMRA/1000).Rad() * d
+	μδ := unit.AngleFromSec(s.PMDec/1000).Rad() * d
+	vr := s.RV * 86400 * base.JulianYear / au
+	t := (jde - base.J2000) / base.JulianYear
+	for i := range r {
+		r[i] = d*p[i] + (μα*eα[i]+μδ*eδ[i]+vr*p[i])*t
+	}
+	return
+}
+
+// Finds the apparent place of a star.
+// Receives:
+//	jd: the Julian day, in UT
+// Returns:
+//	the geocentric apparent place, in equatorial and ecliptic coordinates
+// Notes:
+//	Rigorous: space motion by Propagate, annual parallax and aberration from the heliocentric position and velocity
+//	of the Earth by VSOP87, then precession (Meeus 21.3) and nutation as rotation matrices, so there is no
+//	trouble near the poles. Light deflection by the Sun and the frame bias of ICRS are left out: both are
+//	a few hundredths of an arc second away from the Sun.
+func (s *Star) Apparent(jd float64) Place {
+	jde := deltat.JDE(jd)
+	r := s.Propagate(jde)
+	// annual parallax: from the Sun to the Earth
+	e := earth(jde)
+	for i := range r {
+		r[i] -= e[i]
+	}
+	u := normalize3(r)
+	// aberration, from the velocity of the Earth by a central difference
+	const h = .01
+	e0, e1 := earth(jde-h), earth(jde+h)
+	for i := range u {
+		u[i] += (e1[i] - e0[i]) / (2 * h) / c
+	}
+	u = normalize3(u)
+	u = rotate(precession(jde), u)
+	Δψ, Δε := nutation.Nutation(jde)
+	ε := nutation.MeanObliquity(jde)
+	u = rotate(nutationMatrix(ε, Δψ, Δε), u)
+	var place Place
+	place.RA = unit.RAFromRad(math.Atan2(u[1], u[0]))
+	place.Dec = unit.Angle(math.Asin(u[2]))
+	// to the true ecliptic of date
+	sε, cε := (ε + Δε).Sincos()
+	place.Lon = unit.Angle(math.Atan2(u[1]*cε+u[2]*sε, u[0])).Mod1()
+	place.Lat = unit.Angle(math.Asin(u[2]*cε - u[1]*sε))
+	return place
+}
+
+// Finds the heliocentric position of the Earth.
+// Receives:
+//	jde: the Julian ephemeris day
+// Returns:
+//	the position, in AU, referred to the mean equator and equinox of J2000.0
+func earth(jde float64) [3]float64 {
+	L, B, R := pp.GetEarth().Position2000(jde)
+	sL, cL := L.Sincos()
+	sB, cB := B.Sincos()
+	x, y, z := R*cB*cL, R*cB*sL, R*sB
+	return [3]float64{x, y*base.COblJ2000 - z*base.SOblJ2000, y*base.SOblJ2000 + z*base.COblJ2000}
+}
+
+// Builds the precession matrix from J2000.0 to a date.
+// Receives:
+//	jde: the Julian ephemeris day
+// Returns:
+//	the matrix, from the mean equator and equinox of J2000.0 to those of date
+// Notes:
+//	ζ, z and θ are Meeus 21.3, the IAU 1976 precession.
+func precession(jde float64) [3][3]float64 {
+	t := base.J2000Century(jde)
+	ζ := unit.AngleFromSec(base.Horner(t, 0, 2306.2181, .30188, .017998))
+	z := unit.AngleFromSec(base.Horner(t, 0, 2306.2181, 1.09468, .018203))
+	θ := unit.AngleFromSec(base.Horner(t, 0, 2004.3109, -.42665, -.041833))
+	sζ, cζ := ζ.Sincos()
+	sz, cz := z.Sincos()
+	sθ, cθ := θ.Sincos()
+	return [3][3]float64{
+		{cζ*cθ*cz - sζ*sz, -sζ*cθ*cz - cζ*sz, -sθ * cz},
+		{cζ*cθ*sz + sζ*cz, -sζ*cθ*sz + cζ*cz, -sθ * sz},
+		{cζ * sθ, -sζ * sθ, cθ},
+	}
+}
+
+// Builds the nutation matrix.
+// Receives:
+//	ε: the mean obliquity, as a unit.Angle
+//	Δψ: nutation in longitude, as a unit.Angle
+//	Δε: nutation in obliquity, as a unit.Angle
+// Returns:
+//	the matrix, from the mean equator and equinox of date to the true ones
+func nutationMatrix(ε, Δψ, Δε unit.Angle) [3][3]float64 {
+	sε, cε := ε.Sincos()
+	sεt, cεt := (ε + Δε).Sincos()
+	sψ, cψ := Δψ.Sincos()
+	return [3][3]float64{
+		{cψ, -sψ * cε, -sψ * sε},
+		{sψ * cεt, cψ*cε*cεt + sε*sεt, cψ*sε*cεt - cε*sεt},
+		{sψ * sεt, cψ*cε*sεt - sε*cεt, cψ*sε*sεt + cε*cεt},
+	}
+}
+
+// Multiplies a vector by a matrix.
+func rotate(m [3][3]float64, v [3]float64) (r [3]float64) {
+	for i := range r {
+		r[i] = m[i][0]*v[0] + m[i][1]*v[1] + m[i][2]*v[2]
+	}
+	return
+}
+
+// Scales a vector to unit length.
+func normalize3(v [3]float64) [3]float64 {
+	n := math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2])
+	return [3]float64{v[0] / n, v[1] / n, v[2] / n}
+}
@@ -0,0 +1,147 @@
+// Stars: a catalog of fixed stars, with lookup by name, Bayer designation or HIP number.
+package stars
+
+import (
+	"strconv"
+	"strings"
+
+	unit "webeph/unit"
+)
+
+// Star is a catalog entry.
+type Star struct {
+	Name     string     // proper name
+	Bayer    string     // Bayer designation: a Greek letter, with a component number if any, and the constellation abbreviation
+	HIP      int        // Hipparcos catalog number
+	RA       unit.RA    // right ascension, J2000.0
+	Dec      unit.Angle // declination, J2000.0
+	PMRA     float64    // proper motion in right ascension, μα cos δ, in milliarcseconds per year
+	PMDec    float64    // proper motion in declination, in milliarcseconds per year
+	Parallax float64    // in milliarcseconds
+	RV       float64    // radial velocity, in km/s, positive receding
+	Mag      float64    // visual magnitude
+}
+
+// Greek letters, with their names and the abbreviations of SIMBAD.
+var greek = []struct{ letter, name, abbr string }{
+	{"α", "alpha", "alf"}, {"β", "beta", "bet"}, {"γ", "gamma", "gam"}, {"δ", "delta", "del"},
+	{"ε", "epsilon", "eps"}, {"ζ", "zeta", "zet"}, {"η", "eta", "eta"}, {"θ", "theta", "tet"},
+	{"ι", "iota", "iot"}, {"κ", "kappa", "kap"}, {"λ", "lambda", "lam"}, {"μ", "mu", "mu"},
+	{"ν", "nu", "nu"}, {"ξ", "xi", "ksi"}, {"ο", "omicron", "omi"}, {"π", "pi", "pi"},
+	{"ρ", "rho", "rho"}, {"σ", "sigma", "sig"}, {"τ", "tau", "tau"}, {"υ", "upsilon", "ups"},
+	{"φ", "phi", "phi"}, {"χ", "chi", "chi"}, {"ψ", "psi", "psi"}, {"ω", "omega", "ome"},
+}
+
+// Finds a star by name, Bayer designation or HIP number.
+// Receives:
+//	query: a proper name such as "Regulus", a Bayer designation such as "α Leo" or "alpha Leo",
+//	 or a HIP number such as "HIP 49669"
+// Returns:
+//	index: the index of the star in Catalog
+//	ok: false if no star matches
+// Notes:
+//	Case and spacing are ignored.
+func Find(query string) (index int, ok bool) {
+	if index, ok = ByName(query); ok {
+		return
+	}
+	if index, ok = ByBayer(query); ok {
+		return
+	}
+	fields := strings.Fields(strings.ToUpper(query))
+	if len(fields) > 0 && fields[0] == "HIP" {
+		fields = fields[1:]
+	}
+	if len(fields) == 1 {
+		if hip, err := strconv.Atoi(fields[0]); err == nil {
+			return ByHIP(hip)
+		}
+	}
+	return -1, false
+}
+
+// Finds a star by proper name.
+// Receives:
+//	name: the proper name, in any case
+// Returns:
+//	index: the index of the star in Catalog
+//	ok: false if no star has that name
+func ByName(name string) (index int, ok bool) {
+	name = normalize(name)
+	for i := range Catalog {
+		if normalize(Catalog[i].Name) == name {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// Finds a star by Bayer designation.
+// Receives:
+//	bayer: the designation, with the Greek letter written as a letter, a name or an abbreviation: "α Leo", "alpha Leo", "alf Leo"
+// Returns:
+//	index: the index of the star in Catalog
+//	ok: false if no star has that designation
+// Notes:
+//	A designation without a component number finds the first component: "α Cen" is α1 Cen.
+func ByBayer(bayer string) (index int, ok bool) {
+	bayer = normalizeBayer(bayer)
+	for i := range Catalog {
+		if normalizeBayer(Catalog[i].Bayer) == bayer {
+			return i, true
+		}
+	}
+	// the first component, for a designation without one
+	for i := range Catalog {
+		if normalizeBayer(strings.Replace(Catalog[i].Bayer, "1 ", " ", 1)) == bayer {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// Finds a star by Hipparcos catalog number.
+// Receives:
+//	hip: the HIP number
+// Returns:
+//	index: the index of the star in Catalog
+//	ok: false if the star is not in the catalog
+func ByHIP(hip int) (index int, ok bool) {
+	for i := range Catalog {
+		if Catalog[i].HIP == hip {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// Finds the apparent ecliptic position of a star.
+// Receives:
+//	jd: the Julian day, in UT
+// Returns:
+//	λ: the geocentric ecliptic longitude, as a unit.Angle
+//	β: the geocentric ecliptic latitude, as a unit.Angle
+// Notes:
+//	The ecliptic half of Apparent, referred to the true ecliptic of date.
+func (s *Star) Position(jd float64) (λ, β unit.Angle) {
+	p := s.Apparent(jd)
+	return p.Lon, p.Lat
+}
+
+// Folds case and spacing.
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), "")
+}
+
+// Folds case and spacing, and writes the Greek letter of a Bayer designation as a letter.
+func normalizeBayer(s string) string {
+	s = normalize(s)
+	for _, g := range greek {
+		for _, prefix := range []string{g.name, g.abbr} {
+			if strings.HasPrefix(s, prefix) {
+				return g.letter + s[len(prefix):]
+			}
+		}
+	}
+	return s
+}
@@ -0,0 +1,152 @@
+package stars_test
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"math"
+	"os"
+	"testing"
+
+	base "webeph/base"
+	stars "webeph/stars"
+	hipparcos "webeph/stars/hipparcos"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+)
+
+func TestFind(t *testing.T) {
+	for _, c := range []struct {
+		query string
+		want  string
+	}{
+		{"Regulus", "Regulus"},
+		{"rigil  KENTAURUS", "Rigil Kentaurus"},
+		{"α Leo", "Regulus"},
+		{"alpha leo", "Regulus"},
+		{"alf Leo", "Regulus"},
+		{"α Cen", "Rigil Kentaurus"},
+		{"alpha2 Lib", "Zubenelgenubi"},
+		{"HIP 14576", "Algol"},
+		{"65474", "Spica"},
+	} {
+		i, ok := stars.Find(c.query)
+		if !ok || stars.Catalog[i].Name != c.want {
+			t.Errorf("%q: found %v, want %s", c.query, ok, c.want)
+		}
+	}
+	for _, query := range []string{"Nibiru", "α Foo", "HIP 1", ""} {
+		if _, ok := stars.Find(query); ok {
+			t.Errorf("%q should not be found", query)
+		}
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	names := map[string]bool{}
+	hips := map[int]bool{}
+	for i, s := range stars.Catalog {
+		if names[s.Name] || hips[s.HIP] {
+			t.Errorf("%s is listed twice", s.Name)
+		}
+		names[s.Name] = true
+		hips[s.HIP] = true
+		if i > 0 && s.Mag < stars.Catalog[i-1].Mag {
+			t.Errorf("%s is out of order of brightness", s.Name)
+		}
+	}
+}
+
+func TestGenerated(t *testing.T) {
+	// gen.go leaves the rows it used in testdata/hipparcos.
+	src, err := hipparcos.Read("testdata/hipparcos")
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skip("catalog.go has not been generated")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := src.Stars()
+	if err != nil {
+		t.Fatal(err)
+	}
+	catalog := hipparcos.Select(all, hipparcos.Count)
+	if len(stars.Catalog) != len(catalog) {
+		t.Errorf("%d stars, want %d", len(stars.Catalog), len(catalog))
+	}
+	var b bytes.Buffer
+	if err = hipparcos.Write(&b, catalog); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.ReadFile("catalog.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(file, b.Bytes()) {
+		t.Error("catalog.go differs from its source; run go generate")
+	}
+}
+
+func TestPosition(t *testing.T) {
+	// 01/26/22@1510 EST, as in the zabinski.FindStellarLongitude tests
+	jd := 2459606.340277778
+	for _, c := range []struct {
+		name string
+		λ, β float64
+	}{
+		{"Algol", 56.466667, 22.43},
+		{"Spica", 204.15, -2.05},
+		{"Arcturus", 204.533334, 30.73},
+		{"Regulus", 150.13, .46},
+	} {
+		i, _ := stars.ByName(c.name)
+		λ, β := stars.Catalog[i].Position(jd)
+		if !testutils.CheckTolerance(λ.Deg(), c.λ, testutils.StandardTolerance) {
+			t.Errorf("%s: λ %v, want %v", c.name, λ.Deg(), c.λ)
+		}
+		if !testutils.CheckTolerance(β.Deg(), c.β, testutils.StandardTolerance) {
+			t.Errorf("%s: β %v, want %v", c.name, β.Deg(), c.β)
+		}
+	}
+}
+
+func TestApparent(t *testing.T) {
+	// Meeus, Example 23.a: θ Persei, 2028 November 13.19 TD, without parallax.
+	dec := unit.NewAngle(' ', 49, 13, 42.48)
+	s := stars.Star{
+		RA:    unit.NewRA(2, 44, 11.986),
+		Dec:   dec,
+		PMRA:  .03425 * 15 * 1000 * dec.Cos(),
+		PMDec: -89.5,
+	}
+	// JDE 2462088.69, less ΔT
+	p := s.Apparent(2462088.6891102148)
+	if !testutils.CheckTolerance(p.RA.Deg(), unit.NewRA(2, 46, 14.39).Deg(), .05/3600) {
+		t.Errorf("α %v, want 2h46m14.39s", p.RA.Time())
+	}
+	if !testutils.CheckTolerance(p.Dec.Deg(), unit.NewAngle(' ', 49, 21, 7.45).Deg(), .05/3600) {
+		t.Errorf("δ %v, want +49°21′07.45″", p.Dec.Deg())
+	}
+}
+
+func TestPropagate(t *testing.T) {
+	// Barnard's Star comes nearest the Sun around AD 11,800, at 3.75 light years or 1.15 pc.
+	s := stars.Star{
+		RA:       unit.NewRA(17, 57, 48.498),
+		Dec:      unit.NewAngle(' ', 4, 41, 36.21),
+		PMRA:     -798.58,
+		PMDec:    10328.12,
+		Parallax: 548.31,
+		RV:       -110.51,
+	}
+	nearest, year := math.Inf(1), 0.
+	for y := 2000.; y < 20000; y += 10 {
+		r := s.Propagate(base.JulianYearToJDE(y))
+		if d := math.Sqrt(r[0]*r[0]+r[1]*r[1]+r[2]*r[2]) / (648000 / math.Pi); d < nearest {
+			nearest, year = d, y
+		}
+	}
+	if !testutils.CheckTolerance(nearest, 1.15, .01) || !testutils.CheckTolerance(year, 11800, 200) {
+		t.Errorf("nearest %v pc in %v", nearest, year)
+	}
+}
@@ -0,0 +1,116 @@
+// Stations: times when a planet's motion in longitude stops and reverses.
+package stations
+
+import (
+	"errors"
+	"math"
+
+	iterate "webeph/iterate"
+	pp "webeph/planetposition"
+	unit "webeph/unit"
+	web "webeph/web"
+)
+
+// Station types.
+const (
+	Retrograde = iota // station retrograde (SR): direct motion turns retrograde
+	Direct            // station direct (SD): retrograde motion turns direct
+)
+
+// Station is the moment a planet's motion in longitude reverses.
+type Station struct {
+	JD   float64    // Julian day, in UT
+	Type int        // Retrograde or Direct
+	Lon  unit.Angle // ecliptic longitude at the station, in the zodiac set by web.SetZodiac
+}
+
+const (
+	// Scan step, in days. Mercury's shortest retrograde lasts about three weeks.
+	scanStep = 1.
+	// Window around a geocentric station searched for topocentric stations, in days.
+	topoWindow = 2.
+	// Scan step inside that window, in days.
+	topoStep = 1. / 24
+	// Width of the final bracket, in days: about a second.
+	tolerance = 1. / 86400
+)
+
+// Finds the stations of a planet between two dates.
+// Receives:
+//	planet: the required body, as a planetposition constant
+//	jdStart: the start of the search, as a Julian day in UT
+//	jdEnd: the end of the search, as a Julian day in UT
+//	site: the observer, or nil for geocentric stations
+// Returns:
+//	stations: the stations found, in order of time
+//	err: any errors encountered
+// Notes:
+//	Stations are roots of the longitude speed from web.FindSpeed, bracketed by a daily scan and refined by bisection.
+//	The zodiac shifts longitudes but not speeds: only Station.Lon follows web.SetZodiac.
+//	Topocentric speed carries a daily wobble from parallax. Near a geocentric station it can change sign several
+//	times within a few hours, so each geocentric station is searched again hour by hour on the topocentric path,
+//	and every sign change found there is reported.
+func Find(planet int, jdStart, jdEnd float64, site *web.Site) (stations []Station, err error) {
+	if planet == pp.Sun || planet == pp.Moon {
+		return nil, errors.New("Body has no stations.")
+	}
+	geocentric, err := scan(planet, jdStart, jdEnd, scanStep, nil)
+	if err != nil || site == nil {
+		return geocentric, err
+	}
+	last := math.Inf(-1)
+	for _, s := range geocentric {
+		from := math.Max(math.Max(s.JD-topoWindow, jdStart), last)
+		to := math.Min(s.JD+topoWindow, jdEnd)
+		topocentric, err := scan(planet, from, to, topoStep, site)
+		if err != nil {
+			return nil, err
+		}
+		stations = append(stations, topocentric...)
+		last = to
+	}
+	return stations, nil
+}
+
+// Scans for sign changes of the longitude speed.
+// Receives:
+//	planet: the required body, as a planetposition constant
+//	jdStart: the start of the scan, as a Julian day in UT
+//	jdEnd: the end of the scan, as a Julian day in UT
+//	step: the scan step, in days
+//	site: the observer, or nil for geocentric stations
+// Returns:
+//	stations: the stations found, in order of time
+//	err: any errors encountered
+func scan(planet int, jdStart, jdEnd, step float64, site *web.Site) (stations []Station, err error) {
+	speed := func(jd float64) float64 {
+		dλ, _, _, _, e := web.FindSpeed(jd, planet, site)
+		if e != nil {
+			err = e
+		}
+		return dλ
+	}
+	jd0 := jdStart
+	v0 := speed(jd0)
+	for jd0 < jdEnd && err == nil {
+		jd1 := math.Min(jd0+step, jdEnd)
+		v1 := speed(jd1)
+		if (v0 > 0) != (v1 > 0) {
+			jd := iterate.BinaryRoot(speed, jd0, jd1, tolerance)
+			λ, _, _, e := web.FindPosition(jd, planet, site)
+			if e != nil {
+				return nil, e
+			}
+			s := Station{JD: jd, Type: Direct, Lon: λ}
+			if v0 > 0 {
+				s.Type = Retrograde
+			}
+			stations = append(stations, s)
+		}
+		jd0, v0 = jd1, v1
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stations, nil
+}
@@ -0,0 +1,81 @@
+package stations_test
+
+import (
+	"testing"
+
+	julian "webeph/julian"
+	pp "webeph/planetposition"
+	stations "webeph/stations"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+	web "webeph/web"
+)
+
+// Published station times, in UT, rounded to the minute, and tropical longitudes, rounded to the minute of arc.
+// Mercury's speed turns quickly and its stations agree to the minute. Jupiter's speed passes through zero so slowly
+// that the small errors of the truncated theories move its station by several minutes.
+var published = []struct {
+	planet  int
+	jd      float64
+	kind    int
+	λ       float64
+	minutes float64
+}{
+	// Mercury stations retrograde at 10°20′ Aquarius
+	{pp.Mercury, julian.CalendarGregorianToJD(2022, 1, 14+(11+41./60)/24), stations.Retrograde, 310.34, 1},
+	// and direct at 24°21′ Capricorn
+	{pp.Mercury, julian.CalendarGregorianToJD(2022, 2, 4+(4+13./60)/24), stations.Direct, 294.38, 1},
+	// Jupiter stations retrograde at 8°44′ Aries
+	{pp.Jupiter, julian.CalendarGregorianToJD(2022, 7, 28+(20+38./60)/24), stations.Retrograde, 8.73, 10},
+}
+
+func TestPublished(t *testing.T) {
+	// The published longitudes are tropical.
+	defer web.SetZodiac(web.Zodiac())
+	web.SetZodiac(web.Tropical)
+	for _, p := range published {
+		found, err := stations.Find(p.planet, p.jd-10, p.jd+10, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != 1 {
+			t.Fatalf("planet %d: found %d stations, want 1", p.planet, len(found))
+		}
+		s := found[0]
+		if s.Type != p.kind {
+			t.Errorf("planet %d: type %d, want %d", p.planet, s.Type, p.kind)
+		}
+		if !testutils.CheckTolerance(s.JD, p.jd, p.minutes*testutils.JulianMinuteTolerance) {
+			t.Errorf("planet %d: JD %v, want %v", p.planet, s.JD, p.jd)
+		}
+		if !testutils.CheckTolerance(s.Lon.Deg(), p.λ, 0.02) {
+			t.Errorf("planet %d: longitude %v, want %v", p.planet, s.Lon.Deg(), p.λ)
+		}
+	}
+}
+
+func TestTopocentric(t *testing.T) {
+	// Parallax moves the station by minutes, not days.
+	jd := published[0].jd
+	site := &web.Site{Lat: unit.AngleFromDeg(40.7), Lon: unit.AngleFromDeg(-74)}
+	found, err := stations.Find(pp.Mercury, jd-10, jd+10, site)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) == 0 {
+		t.Fatal("no topocentric station found")
+	}
+	for _, s := range found {
+		if !testutils.CheckTolerance(s.JD, jd, 60*testutils.JulianMinuteTolerance) {
+			t.Errorf("topocentric JD %v, want near %v", s.JD, jd)
+		}
+	}
+}
+
+func TestLuminaries(t *testing.T) {
+	for _, p := range []int{pp.Sun, pp.Moon} {
+		if _, err := stations.Find(p, 2459580.5, 2459600.5, nil); err == nil {
+			t.Errorf("body %d: expected error", p)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+//go:build js && wasm
+
+package web
+
+import (
+	lots "webeph/lots"
+	unit "webeph/unit"
+)
+
+// The most formulas findCustomLots takes at once.
+const maxFormulas = 32
+
+var (
+	lotsContainer       = [6]float64{}
+	formulaContainer    = [4 * maxFormulas]int32{}
+	customLotsContainer = [maxFormulas + 1]float64{}
+)
+
+// Gets the array containing the lots.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for Fortune, Spirit, Eros, Necessity and Marriage, then the sect.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getLotsContainer
+func GetLotsContainer() *[6]float64 {
+	return &lotsContainer
+}
+
+// Finds the standard lots for a time and place.
+// Receives:
+//	jd: the Julian day, in UT
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height above mean sea level, in meters
+// Returns:
+//	true if the lots were found; false on error
+// Notes:
+//	Stores the lots in degrees, in the zodiac set by setZodiac, then 1 for a day chart or 0 for a night chart.
+//	Use getLotsContainer to recover results. On error, sets ErrMsg and zeroes the container.
+//export findLots
+func findLots(jd float64, φ, ο unit.Angle, h float64) bool {
+	found, diurnal, err := FindLots(jd, &Site{Lat: φ, Lon: ο, Height: h})
+	if err != nil {
+		ErrMsg = err.Error()
+		lotsContainer = [6]float64{}
+		return false
+	}
+	for i, λ := range found {
+		lotsContainer[i] = λ.Deg()
+	}
+	lotsContainer[5] = 0
+	if diurnal {
+		lotsContainer[5] = 1
+	}
+	return true
+}
+
+// Gets the array that receives the formulas of custom lots.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for the formulas: four integers each, A, B and C, then 1 to reverse by night or 0
+// Notes:
+//	Javascript writes the formulas here before calling findCustomLots. Points are planetposition constants, then
+//	the lots package constants: Ascendant, Fortune, Spirit, Eros, Necessity and Marriage.
+//export getFormulaContainer
+func GetFormulaContainer() *[4 * maxFormulas]int32 {
+	return &formulaContainer
+}
+
+// Gets the array containing custom lots.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for the lots, in the order of the formulas, then the sect.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getCustomLotsContainer
+func GetCustomLotsContainer() *[maxFormulas + 1]float64 {
+	return &customLotsContainer
+}
+
+// Finds lots cast from formulas written to getFormulaContainer.
+// Receives:
+//	jd: the Julian day, in UT
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height above mean sea level, in meters
+//	count: the number of formulas, up to 32
+// Returns:
+//	true if the lots were found; false on error, for more than 32 formulas, an unknown point, or Pluto outside 1885 to 2099
+// Notes:
+//	Stores count lots in degrees, in the zodiac set by setZodiac, then 1 for a day chart or 0 for a night chart.
+//	Use getCustomLotsContainer to recover results. On error, sets ErrMsg and zeroes the container.
+//export findCustomLots
+func findCustomLots(jd float64, φ, ο unit.Angle, h float64, count int) bool {
+	if count < 0 || count > maxFormulas {
+		ErrMsg = "Invalid number of formulas."
+		customLotsContainer = [maxFormulas + 1]float64{}
+		return false
+	}
+	formulas := make([]lots.Formula, count)
+	for i := range formulas {
+		f := formulaContainer[4*i : 4*i+4]
+		formulas[i] = lots.Formula{A: int(f[0]), B: int(f[1]), C: int(f[2]), Reverse: f[3] != 0}
+	}
+	found, diurnal, err := FindCustomLots(jd, &Site{Lat: φ, Lon: ο, Height: h}, formulas)
+	if err != nil {
+		ErrMsg = err.Error()
+		customLotsContainer = [maxFormulas + 1]float64{}
+		return false
+	}
+	for i, λ := range found {
+		customLotsContainer[i] = λ.Deg()
+	}
+	customLotsContainer[count] = 0
+	if diurnal {
+		customLotsContainer[count] = 1
+	}
+	return true
+}
@@ -3,6 +3,7 @@
 package web
 
 import (
+	deltat "webeph/deltat"
 	nutation "webeph/nutation"
 	unit "webeph/unit"
 	zabinski "webeph/zabinski"
@@ -26,7 +27,7 @@ func GetObliquityLSTContainer() *[2]unit.Angle {
 
 // Finds obliquity and local sidereal time.
 // Receives:
-//	jd: the Julian day
+//	jd: the Julian day, in UT
 //	ο: the longitude, as a unit.Angle
 // Returns:
 //	nothing.
@@ -34,8 +35,9 @@ func GetObliquityLSTContainer() *[2]unit.Angle {
 //	Results are stored in a container internal to the package. Use getObliquityLSTContainer to recover results.
 //export findObliquityLST
 func FindObliquityAndLST(jd float64, ο unit.Angle) {
-	Δψ, Δε := nutation.Nutation(jd)
-	ε := zabinski.FindObliquity(Δε, jd)
+	jde := deltat.JDE(jd)
+	Δψ, Δε := nutation.Nutation(jde)
+	ε := zabinski.FindObliquity(Δε, jde)
 	lst := zabinski.FindSiderealTime(Δψ, Δε, jd, ο)
 	angles = [2]unit.Angle{ε, lst.Angle()}
 }
@@ -0,0 +1,69 @@
+//go:build js && wasm
+
+package web
+
+import (
+	ayanamsa "webeph/ayanamsa"
+	unit "webeph/unit"
+)
+
+// Sets the zodiac of the longitudes found by this package.
+// Receives:
+//	system: Tropical (-1), or an ayanamsa constant for a sidereal zodiac
+// Returns:
+//	nothing
+// Notes:
+//	On error, sets ErrMsg and leaves the zodiac unchanged.
+//export setZodiac
+func setZodiac(system int) {
+	if err := SetZodiac(system); err != nil {
+		ErrMsg = err.Error()
+	}
+}
+
+// Sets the user-defined ayanamsa.
+// Receives:
+//	epoch: the Julian day at which the value holds
+//	value: the ayanamsa at the epoch, as a unit.Angle
+// Returns:
+//	nothing
+//export setUserAyanamsa
+func setUserAyanamsa(epoch float64, value unit.Angle) {
+	ayanamsa.SetUserDefined(epoch, value)
+}
+
+// Finds an ayanamsa.
+// Receives:
+//	jd: the Julian day
+//	system: the ayanamsa, as an ayanamsa constant
+// Returns:
+//	the ayanamsa, in degrees
+// Notes:
+//	On error, sets ErrMsg and returns 0.
+//export findAyanamsa
+func findAyanamsa(jd float64, system int) float64 {
+	a, err := ayanamsa.Find(system, jd)
+	if err != nil {
+		ErrMsg = err.Error()
+		return 0
+	}
+	return a.Deg()
+}
+
+// Converts a tropical longitude to the zodiac set by setZodiac.
+// Receives:
+//	λ: the tropical longitude, as a unit.Angle
+//	jd: the Julian day
+// Returns:
+//	the longitude in the zodiac, in degrees
+// Notes:
+//	For house cusps, nodes and stars. On error, sets ErrMsg and returns 0.
+//export toZodiac
+func toZodiac(λ unit.Angle, jd float64) float64 {
+	λ, err := ToZodiac(λ, jd)
+	if err != nil {
+		ErrMsg = err.Error()
+		return 0
+	}
+	return λ.Deg()
+}
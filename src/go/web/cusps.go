@@ -0,0 +1,33 @@
+package web
+
+import (
+	deltat "webeph/deltat"
+	houses "webeph/houses"
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+// Finds the house cusps for a time and place.
+// Receives:
+//	jd: the Julian day, in UT
+//	site: the observer
+//	system: the house system, as a houses package constant
+// Returns:
+//	cusps: the twelve cusps, house 1 first, in the zodiac set by SetZodiac
+//	err: any errors encountered
+// Notes:
+//	The native counterpart of the findHouses export, from the Julian day rather than sidereal time and obliquity.
+//	Nutation is left out, as in FindChart.
+func FindHouseCusps(jd float64, site *Site, system int) (cusps [12]unit.Angle, err error) {
+	ε := zabinski.FindObliquity(0, deltat.JDE(jd))
+	lst := zabinski.FindSiderealTime(0, 0, jd, site.Lon)
+	if cusps, err = houses.Find(system, lst.Angle(), ε, site.Lat); err != nil {
+		return
+	}
+	for i := range cusps {
+		if cusps[i], err = ToZodiac(cusps[i], jd); err != nil {
+			return
+		}
+	}
+	return
+}
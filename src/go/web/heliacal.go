@@ -0,0 +1,83 @@
+package web
+
+import (
+	"errors"
+	"math"
+
+	globe "webeph/globe"
+	heliacal "webeph/heliacal"
+	rise "webeph/rise"
+	stars "webeph/stars"
+	unit "webeph/unit"
+)
+
+// Finds the next heliacal event of a catalog star.
+// Receives:
+//	event: one of the heliacal event constants
+//	jd: the Julian day, in UT, to search from
+//	index: the index of the star in stars.Catalog
+//	av: the arcus visionis, as a unit.Angle, or zero for heliacal.ArcusVisionis of the star's magnitude
+//	site: the observer
+// Returns:
+//	t: the Julian day, in UT, of the star's rising or setting on the day of the event; zero if none
+//	err: any errors encountered
+// Notes:
+//	The star rises and sets on the standard horizon, dipped for the height of the site.
+func FindStarHeliacal(event int, jd float64, index int, av unit.Angle, site *Site) (t float64, err error) {
+	if index < 0 || index >= len(stars.Catalog) {
+		return 0, errors.New("Invalid star.")
+	}
+	star := &stars.Catalog[index]
+	if av == 0 {
+		av = heliacal.ArcusVisionis(star.Mag)
+	}
+	// The apparent place moves well under an arc second a day, so it is found once a day.
+	day := math.NaN()
+	var place stars.Place
+	position := func(jd float64) (α unit.RA, δ, π, s unit.Angle) {
+		if d := math.Floor(jd); d != day {
+			day, place = d, star.Apparent(d)
+		}
+		return place.RA, place.Dec, 0, 0
+	}
+	return findHeliacal(event, jd, position, av, site)
+}
+
+// Finds the next heliacal event of a planet.
+// Receives:
+//	event: one of the heliacal event constants
+//	jd: the Julian day, in UT, to search from
+//	planet: the required body, as a planetposition constant
+//	av: the arcus visionis, as a unit.Angle
+//	site: the observer
+// Returns:
+//	t: the Julian day, in UT, of the planet's rising or setting on the day of the event; zero if none
+//	err: any errors encountered
+// Notes:
+//	The planet's center rises and sets on the standard horizon, dipped for the height of the site. A planet's arcus visionis
+//	changes with its brightness; heliacal.ArcusVisionis of a typical magnitude will do.
+func FindPlanetHeliacal(event int, jd float64, planet int, av unit.Angle, site *Site) (t float64, err error) {
+	position, err := risePosition(jd, planet)
+	if err != nil {
+		return
+	}
+	return findHeliacal(event, jd, position, av, site)
+}
+
+// Finds the next heliacal event of a body.
+// Receives:
+//	event: one of the heliacal event constants
+//	jd: the Julian day, in UT, to search from
+//	position: the place of the body
+//	av: the arcus visionis, as a unit.Angle
+//	site: the observer
+// Returns:
+//	t: the Julian day, in UT, of the event; zero if none
+//	err: any errors encountered
+func findHeliacal(event int, jd float64, position rise.Position, av unit.Angle, site *Site) (float64, error) {
+	horizon := rise.Standard
+	horizon.UpperLimb = false
+	horizon.Height = site.Height
+	// globe.Coord measures longitude westward.
+	return heliacal.Find(event, position, jd, &globe.Coord{Lat: site.Lat, Lon: -site.Lon}, av, &horizon)
+}
@@ -0,0 +1,53 @@
+//go:build js && wasm
+
+package web
+
+import (
+	unit "webeph/unit"
+)
+
+// Finds the next heliacal event of a catalog star.
+// Receives:
+//	event: one of the heliacal event constants
+//	jd: the Julian day, in UT, to search from
+//	index: the index of the star in the catalog
+//	av: the arcus visionis, as a unit.Angle, or zero to estimate it from the star's magnitude
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height of the observer above the horizon, in meters
+// Returns:
+//	the Julian day of the star's rising or setting on the day of the event, or zero if none
+// Notes:
+//	On error, sets ErrMsg and returns 0.
+//export findStarHeliacal
+func findStarHeliacal(event int, jd float64, index int, av, φ, ο unit.Angle, h float64) float64 {
+	t, err := FindStarHeliacal(event, jd, index, av, &Site{Lat: φ, Lon: ο, Height: h})
+	if err != nil {
+		ErrMsg = err.Error()
+		return 0
+	}
+	return t
+}
+
+// Finds the next heliacal event of a planet.
+// Receives:
+//	event: one of the heliacal event constants
+//	jd: the Julian day, in UT, to search from
+//	planet: the required body, as a planetposition constant
+//	av: the arcus visionis, as a unit.Angle
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height of the observer above the horizon, in meters
+// Returns:
+//	the Julian day of the planet's rising or setting on the day of the event, or zero if none
+// Notes:
+//	On error, sets ErrMsg and returns 0.
+//export findPlanetHeliacal
+func findPlanetHeliacal(event int, jd float64, planet int, av, φ, ο unit.Angle, h float64) float64 {
+	t, err := FindPlanetHeliacal(event, jd, planet, av, &Site{Lat: φ, Lon: ο, Height: h})
+	if err != nil {
+		ErrMsg = err.Error()
+		return 0
+	}
+	return t
+}
@@ -0,0 +1,47 @@
+//go:build js && wasm
+
+package web
+
+import (
+	unit "webeph/unit"
+)
+
+var (
+	horizontalContainer = [5]float64{}
+)
+
+// Gets the array containing the equatorial and horizontal coordinates.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for right ascension, declination, azimuth, altitude and apparent altitude.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getHorizontalContainer
+func GetHorizontalContainer() *[5]float64 {
+	return &horizontalContainer
+}
+
+// Finds topocentric equatorial and horizontal coordinates for a planet.
+// Receives:
+//	jd: the Julian day, in UT
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height above mean sea level, in meters
+//	planet: the required body, as a planetposition constant
+// Returns:
+//	true if the position was found; false on error
+// Notes:
+//	Stores right ascension, declination, azimuth (from north), true altitude and apparent altitude, all in degrees.
+//	Use getHorizontalContainer() to retrieve results. On error, sets ErrMsg and zeroes the container.
+//export findHorizontalPosition
+func findHorizontalPosition(jd float64, φ, ο unit.Angle, h float64, planet int) bool {
+	α, δ, A, alt, altApp, err := FindHorizontalPosition(jd, φ, ο, h, planet)
+	if err != nil {
+		ErrMsg = err.Error()
+		horizontalContainer = [5]float64{}
+		return false
+	}
+	horizontalContainer = [5]float64{α.Deg(), δ.Deg(), A.Deg(), alt.Deg(), altApp.Deg()}
+	return true
+}
@@ -1,12 +1,20 @@
 package web
 
 import (
+	"errors"
+
 	base "webeph/base"
+	coord "webeph/coord"
+	deltat "webeph/deltat"
 	elliptic "webeph/elliptic"
+	globe "webeph/globe"
 	julian "webeph/julian"
 	moonposition "webeph/moonposition"
 	parallax "webeph/parallax"
 	pp "webeph/planetposition"
+	pluto "webeph/pluto"
+	refraction "webeph/refraction"
+	sidereal "webeph/sidereal"
 	solar "webeph/solar"
 	unit "webeph/unit"
 	zabinski "webeph/zabinski"
@@ -20,46 +28,162 @@ import (
 //	φ: geographic latitude, as a unit.Angle
 //	ο: geographic longitude, as a unit.Angle
 //	h: the height above mean sea level, in meters
-//	planet: the required planet, as a fully spelled out string, ie "saturn" for Saturn, etc.
+//	planet: the required body, as a planetposition constant, ie pp.Saturn for Saturn, etc.
 // Returns:
-//	λ: the topocentric ecliptic longitude, as a unit.Angle
+//	λ: the topocentric ecliptic longitude, in the zodiac set by SetZodiac, as a unit.Angle
 //	err: any errors encountered
+// Notes:
+//	The date is in UT, in the calendar in force on that date: see julian.SetReform.
+//	Theories are evaluated at JDE = UT + ΔT; sidereal time stays in UT.
 func FindLongitude(y, m int, t float64, φ, ο unit.Angle, h float64, planet int) (λ unit.Angle, err error) {
-	earth := LoadPlanet(pp.Earth)
-	jd := julian.CalendarGregorianToJD(y, m, t)
+	jd, err := julian.CalendarToJD(y, m, t)
+	if err != nil {
+		return
+	}
+	λ, _, _, err = FindTopocentricPosition(jd, φ, ο, h, planet)
+	return
+}
+
+// Finds topocentric ecliptic longitude, latitude and distance for a planet.
+// Receives:
+//	jd: the Julian day, in UT
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height above mean sea level, in meters
+//	planet: the required body, as a planetposition constant
+// Returns:
+//	λ: the topocentric ecliptic longitude, in the zodiac set by SetZodiac, as a unit.Angle
+//	β: the topocentric ecliptic latitude, as a unit.Angle
+//	Δ: the topocentric distance, in AU
+//	err: any errors encountered
+// Notes:
+//	Uses Meeus formula 40.6. Theories are evaluated at JDE = UT + ΔT; sidereal time stays in UT.
+func FindTopocentricPosition(jd float64, φ, ο unit.Angle, h float64, planet int) (λ, β unit.Angle, Δ float64, err error) {
+	if λ, β, Δ, _, err = topocentricPosition(jd, φ, ο, h, planet); err != nil {
+		return
+	}
+	λ, err = ToZodiac(λ, jd)
+	return
+}
+
+// Finds topocentric equatorial and horizontal coordinates for a planet.
+// Receives:
+//	jd: the Julian day, in UT
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height above mean sea level, in meters
+//	planet: the required body, as a planetposition constant
+// Returns:
+//	α: the topocentric right ascension, as a unit.RA
+//	δ: the topocentric declination, as a unit.Angle
+//	A: the azimuth, measured eastward from north, as a unit.Angle
+//	alt: the true altitude, as a unit.Angle
+//	altApp: the apparent altitude, including refraction, as a unit.Angle
+//	err: any errors encountered
+// Notes:
+//	Refraction uses Saemundsson's formula for standard conditions. Below -1°, where the formula breaks down, no refraction is applied.
+func FindHorizontalPosition(jd float64, φ, ο unit.Angle, h float64, planet int) (α unit.RA, δ, A, alt, altApp unit.Angle, err error) {
+	λ, β, _, ε, err := topocentricPosition(jd, φ, ο, h, planet)
+	if err != nil {
+		return
+	}
+	sε, cε := ε.Sincos()
+	α, δ = coord.EclToEq(λ, β, sε, cε)
+	// globe.Coord measures longitude westward.
+	g := &globe.Coord{Lat: φ, Lon: -ο}
+	// Nutation is left out, as in topocentricPosition, so sidereal time is mean.
+	st := sidereal.Apparent(0, 0, jd)
+	// Meeus measures azimuth from the south.
+	var azS unit.Angle
+	azS, alt = coord.EqToHz(α, δ, g.Lat, g.Lon, st)
+	A = azS.Add(unit.AngleFromDeg(180))
+	altApp = alt
+	if alt.GreaterThanOrEqual(unit.AngleFromDeg(-1)) {
+		altApp = alt + refraction.Saemundsson(alt)
+	}
+	return
+}
+
+// Finds topocentric ecliptic coordinates for a planet, along with the obliquity used.
+// Receives:
+//	jd: the Julian day, in UT
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height above mean sea level, in meters
+//	planet: the required body, as a planetposition constant
+// Returns:
+//	λ: the topocentric ecliptic longitude, as a unit.Angle
+//	β: the topocentric ecliptic latitude, as a unit.Angle
+//	Δ: the topocentric distance, in AU
+//	ε: the obliquity, as a unit.Angle
+//	err: any errors encountered
+func topocentricPosition(jd float64, φ, ο unit.Angle, h float64, planet int) (λ, β unit.Angle, Δ float64, ε unit.Angle, err error) {
+	jde := deltat.JDE(jd)
 	// Nutation is expensive: it more than doubles the calculation time.
 	// Based on tests in seekNutation, it only improves accuracy by around 0.001 arcseconds.
 	// No need to calculate it.
 	// The nutation folder was left in place in case it is needed someday.
 	Δψ := unit.Angle(0.)
 	Δε := unit.Angle(0.)
-	ε := zabinski.FindObliquity(Δε, jd)
+	ε = zabinski.FindObliquity(Δε, jde)
 	lst := zabinski.FindSiderealTime(Δψ, Δε, jd, ο)
+	geocentricλ, geocentricβ, geocentricΔ, plx, err := geocentricPosition(jde, planet, Δψ)
+	if err != nil {
+		return
+	}
+	λ, β, Δ = parallax.TopocentricEcliptic(geocentricλ, geocentricβ, geocentricΔ, φ, h, ε, lst, plx)
+	return
+}
+
+// Finds geocentric ecliptic coordinates and horizontal parallax for a planet.
+// Receives:
+//	jde: the Julian ephemeris day
+//	planet: the required body, as a planetposition constant
+//	Δψ: nutation in longitude, as a unit.Angle
+// Returns:
+//	λ: the geocentric ecliptic longitude, as a unit.Angle
+//	β: the geocentric ecliptic latitude, as a unit.Angle
+//	Δ: the geocentric distance, in AU
+//	plx: the equatorial horizontal parallax, as a unit.Angle
+//	err: any errors encountered
+// Notes:
+//	The Moon's distance comes from moonposition in km; it is converted here so every body reports AU.
+//	Pluto comes from Meeus chapter 37, valid only from 1885 to 2099; outside that range it is an error.
+func geocentricPosition(jde float64, planet int, Δψ unit.Angle) (λ, β unit.Angle, Δ float64, plx unit.Angle, err error) {
+	earth := LoadPlanet(pp.Earth)
 	plData := &pp.V87Planet{}
-	var geocentricλ, geocentricβ, plx unit.Angle
-	var geocentricΔ float64
 	switch planet {
 	case pp.Sun:
-		geocentricλ = solar.ApparentLongitude(base.J2000Century(jd))
-		geocentricβ = 0.
-		geocentricΔ = 1.
-		plx = parallax.Horizontal(geocentricΔ)
+		λ = solar.ApparentLongitude(base.J2000Century(jde))
+		β = 0.
+		Δ = solar.Radius(base.J2000Century(jde))
+		plx = parallax.Horizontal(Δ)
 	case pp.Venus:
 		plData.Ibody = planet
-		geocentricλ, geocentricβ, geocentricΔ = elliptic.EclipticPosition(plData, earth, jd, true, Δψ)
-		plx = parallax.Horizontal(geocentricΔ)
+		λ, β, Δ = elliptic.EclipticPosition(plData, earth, jde, true, Δψ)
+		plx = parallax.Horizontal(Δ)
 	case pp.Mercury:
 		plData.Ibody = planet
-		geocentricλ, geocentricβ, geocentricΔ = elliptic.EclipticPosition(plData, earth, jd, true, Δψ)
-		plx = parallax.Horizontal(geocentricΔ)
+		λ, β, Δ = elliptic.EclipticPosition(plData, earth, jde, true, Δψ)
+		plx = parallax.Horizontal(Δ)
+	case pp.Pluto:
+		if !pluto.InRange(jde) {
+			err = pluto.ErrRange
+			return
+		}
+		plData.Ibody = planet
+		λ, β, Δ = elliptic.EclipticPosition(plData, earth, jde, false, Δψ)
+		plx = parallax.Horizontal(Δ)
 	case pp.Moon:
-		geocentricλ, geocentricβ, geocentricΔ = MoonPosition(jd)
-		plx = moonposition.Parallax(geocentricΔ)
-	default:
+		λ, β, Δ = MoonPosition(jde)
+		plx = moonposition.Parallax(Δ)
+		Δ /= base.AU
+	case pp.Mars, pp.Jupiter, pp.Saturn, pp.Uranus, pp.Neptune:
 		plData = LoadPlanet(planet)
-		geocentricλ, geocentricβ, geocentricΔ = elliptic.EclipticPosition(plData, earth, jd, false, Δψ)
-		plx = parallax.Horizontal(geocentricΔ)
+		λ, β, Δ = elliptic.EclipticPosition(plData, earth, jde, false, Δψ)
+		plx = parallax.Horizontal(Δ)
+	default:
+		err = errors.New("Invalid planet.")
 	}
-	λ = parallax.TopocentricLongitude(geocentricλ, geocentricβ, φ, h, ε, lst, plx)
 	return
 }
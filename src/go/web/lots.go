@@ -0,0 +1,90 @@
+package web
+
+import (
+	deltat "webeph/deltat"
+	lots "webeph/lots"
+	pp "webeph/planetposition"
+	pluto "webeph/pluto"
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+// Finds the chart that lots are cast from.
+// Receives:
+//	jd: the Julian day, in UT
+//	site: the observer
+// Returns:
+//	c: the chart, with topocentric tropical longitudes
+//	err: any errors encountered
+// Notes:
+//	Nutation is left out, as in FindTopocentricPosition. Cast lots with c.Find and convert them with ToZodiac.
+//	Before 1885 and after 2099, Pluto is left at zero: see FindPosition. No standard lot uses it, and FindCustomLots
+//	rejects a formula that does.
+func FindChart(jd float64, site *Site) (c lots.Chart, err error) {
+	var planets [pp.Pluto + 1]unit.Angle
+	for planet := range planets {
+		// Pluto is left at zero outside the range of its theory.
+		if planet == pp.Earth || planet == pp.Pluto && !pluto.InRange(deltat.JDE(jd)) {
+			continue
+		}
+		if planets[planet], _, _, _, err = topocentricPosition(jd, site.Lat, site.Lon, site.Height, planet); err != nil {
+			return
+		}
+	}
+	ε := zabinski.FindObliquity(0, deltat.JDE(jd))
+	lst := zabinski.FindSiderealTime(0, 0, jd, site.Lon)
+	return lots.NewChart(planets, lst.Angle(), ε, site.Lat)
+}
+
+// Finds the standard lots for a time and place.
+// Receives:
+//	jd: the Julian day, in UT
+//	site: the observer
+// Returns:
+//	found: Fortune, Spirit, Eros, Necessity and Marriage, in the zodiac set by SetZodiac
+//	diurnal: true for a day chart
+//	err: any errors encountered
+func FindLots(jd float64, site *Site) (found [5]unit.Angle, diurnal bool, err error) {
+	c, err := FindChart(jd, site)
+	if err != nil {
+		return
+	}
+	found = c.FindStandard()
+	for i := range found {
+		if found[i], err = ToZodiac(found[i], jd); err != nil {
+			return
+		}
+	}
+	return found, c.Diurnal, nil
+}
+
+// Finds lots cast from any formulas, for a time and place.
+// Receives:
+//	jd: the Julian day, in UT
+//	site: the observer
+//	formulas: the formulas, in the terms of lots.Formula
+// Returns:
+//	found: the lots, in the order of the formulas, in the zodiac set by SetZodiac
+//	diurnal: true for a day chart
+//	err: any errors encountered, including an unknown point in a formula, or pluto.ErrRange for a formula using Pluto
+//	before 1885 or after 2099
+func FindCustomLots(jd float64, site *Site, formulas []lots.Formula) (found []unit.Angle, diurnal bool, err error) {
+	for _, f := range formulas {
+		if (f.A == pp.Pluto || f.B == pp.Pluto || f.C == pp.Pluto) && !pluto.InRange(deltat.JDE(jd)) {
+			return nil, false, pluto.ErrRange
+		}
+	}
+	c, err := FindChart(jd, site)
+	if err != nil {
+		return
+	}
+	if found, err = c.FindAll(formulas); err != nil {
+		return
+	}
+	for i := range found {
+		if found[i], err = ToZodiac(found[i], jd); err != nil {
+			return
+		}
+	}
+	return found, c.Diurnal, nil
+}
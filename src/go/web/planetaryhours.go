@@ -0,0 +1,51 @@
+//go:build js && wasm
+
+package web
+
+import (
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+var (
+	planetaryHoursContainer = [73]float64{}
+)
+
+// Gets the array containing the planetary hours.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for the day ruler, then the start, end and ruler of each of the 24 hours.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getPlanetaryHoursContainer
+func GetPlanetaryHoursContainer() *[73]float64 {
+	return &planetaryHoursContainer
+}
+
+// Finds the planetary hours of a local date.
+// Receives:
+//	jde: the Julian day
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+// Returns:
+//	true if the hours were found; false on error, as on a day the Sun does not rise or set
+// Notes:
+//	Rulers are planetposition constants. Use getPlanetaryHoursContainer to recover results.
+//	On error, sets ErrMsg and zeroes the container.
+//export findPlanetaryHours
+func findPlanetaryHours(jde float64, φ, ο unit.Angle) bool {
+	hours, dayRuler, err := zabinski.FindPlanetaryHours(jde, φ, ο)
+	if err != nil {
+		ErrMsg = err.Error()
+		planetaryHoursContainer = [73]float64{}
+		return false
+	}
+	planetaryHoursContainer[0] = float64(dayRuler)
+	for i, hour := range hours {
+		planetaryHoursContainer[1+3*i] = hour.Start
+		planetaryHoursContainer[2+3*i] = hour.End
+		planetaryHoursContainer[3+3*i] = float64(hour.Ruler)
+	}
+	return true
+}
@@ -16,6 +16,10 @@ func LoadPlanet(ibody int) *pp.V87Planet {
 		return pp.GetJupiter()
 	case pp.Saturn:
 		return pp.GetSaturn()
+	case pp.Uranus:
+		return pp.GetUranus()
+	case pp.Neptune:
+		return pp.GetNeptune()
 	default:
 		return pp.GetEarth()
 	}
@@ -0,0 +1,71 @@
+package web
+
+import (
+	"math"
+
+	deltat "webeph/deltat"
+	unit "webeph/unit"
+)
+
+// Observer location, for topocentric positions.
+type Site struct {
+	Lat    unit.Angle // geographic latitude (φ)
+	Lon    unit.Angle // geographic longitude (ο), east positive
+	Height float64    // height above mean sea level, in meters
+}
+
+// Half the interval used to difference positions, in days.
+const speedStep = 0.01
+
+// Finds ecliptic longitude, latitude and distance for a planet.
+// Receives:
+//	jd: the Julian day, in UT
+//	planet: the required body, as a planetposition constant
+//	site: the observer, or nil for a geocentric position
+// Returns:
+//	λ: the ecliptic longitude, in the zodiac set by SetZodiac, as a unit.Angle
+//	β: the ecliptic latitude, as a unit.Angle
+//	Δ: the distance, in AU
+//	err: any errors encountered
+// Notes:
+//	Pluto is valid only from 1885 to 2099, the range of Meeus chapter 37; outside it, err is pluto.ErrRange.
+func FindPosition(jd float64, planet int, site *Site) (λ, β unit.Angle, Δ float64, err error) {
+	if site != nil {
+		return FindTopocentricPosition(jd, site.Lat, site.Lon, site.Height, planet)
+	}
+	if λ, β, Δ, _, err = geocentricPosition(deltat.JDE(jd), planet, 0); err != nil {
+		return
+	}
+	λ, err = ToZodiac(λ, jd)
+	return
+}
+
+// Finds the daily motion of a planet.
+// Receives:
+//	jd: the Julian day, in UT
+//	planet: the required body, as a planetposition constant
+//	site: the observer, or nil for a geocentric position
+// Returns:
+//	dλ: the motion in longitude, in degrees per day
+//	dβ: the motion in latitude, in degrees per day
+//	dΔ: the motion in distance, in AU per day
+//	retrograde: true if the longitude is decreasing
+//	err: any errors encountered
+// Notes:
+//	Uses a central difference over 0.02 days, short enough to follow the Moon.
+func FindSpeed(jd float64, planet int, site *Site) (dλ, dβ, dΔ float64, retrograde bool, err error) {
+	λ0, β0, Δ0, err := FindPosition(jd-speedStep, planet, site)
+	if err != nil {
+		return
+	}
+	λ1, β1, Δ1, err := FindPosition(jd+speedStep, planet, site)
+	if err != nil {
+		return
+	}
+	// longitude may wrap through 0°
+	dλ = unit.Angle(math.Remainder((λ1 - λ0).Rad(), 2*math.Pi)).Deg() / (2 * speedStep)
+	dβ = (β1 - β0).Deg() / (2 * speedStep)
+	dΔ = (Δ1 - Δ0) / (2 * speedStep)
+	retrograde = dλ < 0
+	return
+}
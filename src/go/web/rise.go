@@ -0,0 +1,71 @@
+package web
+
+import (
+	"math"
+
+	deltat "webeph/deltat"
+	globe "webeph/globe"
+	pp "webeph/planetposition"
+	rise "webeph/rise"
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+// Semidiameters at a distance of 1 AU, Meeus p. 389. The Moon's follows from its parallax.
+var semidiameters = [...]unit.Angle{
+	pp.Mercury: unit.AngleFromSec(3.36),
+	pp.Venus:   unit.AngleFromSec(8.41),
+	pp.Mars:    unit.AngleFromSec(4.68),
+	pp.Jupiter: unit.AngleFromSec(98.44),
+	pp.Saturn:  unit.AngleFromSec(82.73),
+	pp.Sun:     unit.AngleFromSec(959.63),
+	pp.Uranus:  unit.AngleFromSec(35.02),
+	pp.Neptune: unit.AngleFromSec(33.50),
+	pp.Pluto:   unit.AngleFromSec(2.07),
+}
+
+// Finds the rising, transit and setting of a planet within a day.
+// Receives:
+//	jd: the start of the day, as a Julian day in UT
+//	planet: the required body, as a planetposition constant
+//	site: the observer
+//	horizon: the horizon, or nil for rise.Standard
+// Returns:
+//	times: the events in [jd, jd+1), with their status
+//	err: any errors encountered
+// Notes:
+//	Start the day at local midnight to get the events of the local date. Positions are geocentric;
+//	parallax enters through the altitude of the horizon, as in Meeus chapter 15.
+func FindRiseTransitSet(jd float64, planet int, site *Site, horizon *rise.Horizon) (times rise.Times, err error) {
+	position, err := risePosition(jd, planet)
+	if err != nil {
+		return
+	}
+	// globe.Coord measures longitude westward.
+	return rise.Find(position, jd, &globe.Coord{Lat: site.Lat, Lon: -site.Lon}, horizon), nil
+}
+
+// Builds the place of a planet for rise.Find.
+// Receives:
+//	jd: a Julian day, in UT, to check the planet on
+//	planet: the required body, as a planetposition constant
+// Returns:
+//	position: the geocentric right ascension, declination, parallax and semidiameter, as a function of the Julian day
+//	err: any errors encountered
+func risePosition(jd float64, planet int) (position rise.Position, err error) {
+	if _, _, _, _, err = geocentricPosition(deltat.JDE(jd), planet, 0); err != nil {
+		return
+	}
+	ecliptic := func(jde float64) (λ, β unit.Angle, Δ float64, π unit.Angle) {
+		λ, β, Δ, π, _ = geocentricPosition(jde, planet, 0)
+		return
+	}
+	semidiameter := func(Δ float64, π unit.Angle) unit.Angle {
+		if planet == pp.Moon {
+			// k = 0.272481, p. 390
+			return unit.Angle(math.Asin(.272481 * π.Sin()))
+		}
+		return semidiameters[planet].Div(Δ)
+	}
+	return zabinski.RisePosition(ecliptic, semidiameter), nil
+}
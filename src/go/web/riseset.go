@@ -0,0 +1,51 @@
+//go:build js && wasm
+
+package web
+
+import (
+	rise "webeph/rise"
+	unit "webeph/unit"
+)
+
+var (
+	riseSetContainer = [4]float64{}
+)
+
+// Gets the array containing rising, transit and setting.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for rising, transit, setting and status.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getRiseSetContainer
+func GetRiseSetContainer() *[4]float64 {
+	return &riseSetContainer
+}
+
+// Finds the rising, transit and setting of a planet within a day.
+// Receives:
+//	jd: the start of the day, as a Julian day in UT
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height of the observer above the horizon, in meters
+//	planet: the required body, as a planetposition constant
+// Returns:
+//	true if the events were found; false on error
+// Notes:
+//	Uses the standard horizon, dipped for the height. Stores rising, transit and setting as Julian days, zero for an
+//	event that does not happen within the day, then the status: 0 normal, 1 circumpolar, 2 never rises.
+//	Use getRiseSetContainer to recover results. On error, sets ErrMsg and zeroes the container.
+//export findRiseTransitSet
+func findRiseTransitSet(jd float64, φ, ο unit.Angle, h float64, planet int) bool {
+	horizon := rise.Standard
+	horizon.Height = h
+	times, err := FindRiseTransitSet(jd, planet, &Site{Lat: φ, Lon: ο}, &horizon)
+	if err != nil {
+		ErrMsg = err.Error()
+		riseSetContainer = [4]float64{}
+		return false
+	}
+	riseSetContainer = [4]float64{times.Rise, times.Transit, times.Set, float64(times.Status)}
+	return true
+}
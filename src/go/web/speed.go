@@ -0,0 +1,44 @@
+//go:build js && wasm
+
+package web
+
+var (
+	speedContainer = [4]float64{}
+)
+
+// Gets the array containing the daily motion.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for longitude, latitude and distance speeds, and the retrograde flag.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getSpeedContainer
+func GetSpeedContainer() *[4]float64 {
+	return &speedContainer
+}
+
+// Finds the geocentric daily motion of a planet.
+// Receives:
+//	jd: the Julian day, in UT
+//	planet: the required body, as a planetposition constant
+// Returns:
+//	true if the motion was found; false on error
+// Notes:
+//	Stores longitude and latitude speeds in degrees per day, distance speed in AU per day, then 1 if retrograde
+//	or 0 if direct. Use getSpeedContainer to recover results. On error, sets ErrMsg and zeroes the container.
+//export findSpeed
+func findSpeed(jd float64, planet int) bool {
+	dλ, dβ, dΔ, retrograde, err := FindSpeed(jd, planet, nil)
+	if err != nil {
+		ErrMsg = err.Error()
+		speedContainer = [4]float64{}
+		return false
+	}
+	r := 0.
+	if retrograde {
+		r = 1
+	}
+	speedContainer = [4]float64{dλ, dβ, dΔ, r}
+	return true
+}
@@ -0,0 +1,23 @@
+package web
+
+import (
+	"errors"
+
+	stars "webeph/stars"
+)
+
+// Finds the apparent place of a catalog star.
+// Receives:
+//	index: the index of the star in stars.Catalog
+//	jd: the Julian day, in UT
+// Returns:
+//	place: the geocentric apparent place from stars.Star.Apparent, with the longitude in the zodiac set by SetZodiac
+//	err: any errors encountered
+func FindStarPosition(index int, jd float64) (place stars.Place, err error) {
+	if index < 0 || index >= len(stars.Catalog) {
+		return place, errors.New("Invalid star.")
+	}
+	place = stars.Catalog[index].Apparent(jd)
+	place.Lon, err = ToZodiac(place.Lon, jd)
+	return
+}
@@ -0,0 +1,94 @@
+//go:build js && wasm
+
+package web
+
+import (
+	stars "webeph/stars"
+)
+
+var (
+	starNameContainer     = [64]byte{}
+	starPositionContainer = [4]float64{}
+)
+
+// Gets the array that carries a star name.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for a star name, as UTF-8 bytes.
+// Notes:
+//	Javascript writes a name here before calling findStarIndex, and reads one here after calling findStarName.
+//export getStarNameContainer
+func GetStarNameContainer() *[64]byte {
+	return &starNameContainer
+}
+
+// Gets the array containing the position of a star.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for longitude, latitude, right ascension and declination.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getStarPositionContainer
+func GetStarPositionContainer() *[4]float64 {
+	return &starPositionContainer
+}
+
+// Counts the stars in the catalog.
+// Receives:
+//	nothing
+// Returns:
+//	the number of stars; indexes run from 0 to one less
+//export getStarCount
+func getStarCount() int {
+	return len(stars.Catalog)
+}
+
+// Finds a star by name, Bayer designation or HIP number.
+// Receives:
+//	nameLength: the length in bytes of the query written to getStarNameContainer
+// Returns:
+//	the index of the star in the catalog, or -1 if none matches
+//export findStarIndex
+func findStarIndex(nameLength int) int {
+	if nameLength < 0 || nameLength > len(starNameContainer) {
+		return -1
+	}
+	index, _ := stars.Find(string(starNameContainer[:nameLength]))
+	return index
+}
+
+// Finds the name of a catalog star.
+// Receives:
+//	index: the index of the star in the catalog
+// Returns:
+//	the length in bytes of the name, written to getStarNameContainer, or -1 for an invalid index
+//export findStarName
+func findStarName(index int) int {
+	if index < 0 || index >= len(stars.Catalog) {
+		return -1
+	}
+	return copy(starNameContainer[:], stars.Catalog[index].Name)
+}
+
+// Finds the apparent place of a catalog star.
+// Receives:
+//	index: the index of the star in the catalog
+//	jd: the Julian day, in UT
+// Returns:
+//	true if the place was found; false on error, for an invalid index
+// Notes:
+//	Stores the apparent longitude, in the zodiac set by setZodiac, latitude, right ascension and declination, in degrees. Use getStarPositionContainer to recover results.
+//	On error, sets ErrMsg and zeroes the container.
+//export findStarPosition
+func findStarPosition(index int, jd float64) bool {
+	place, err := FindStarPosition(index, jd)
+	if err != nil {
+		ErrMsg = err.Error()
+		starPositionContainer = [4]float64{}
+		return false
+	}
+	starPositionContainer = [4]float64{place.Lon.Deg(), place.Lat.Deg(), place.RA.Deg(), place.Dec.Deg()}
+	return true
+}
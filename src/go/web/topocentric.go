@@ -0,0 +1,47 @@
+//go:build js && wasm
+
+package web
+
+import (
+	unit "webeph/unit"
+)
+
+var (
+	topocentricContainer = [3]float64{}
+)
+
+// Gets the array containing the topocentric position.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for longitude, latitude and distance.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getTopocentricContainer
+func GetTopocentricContainer() *[3]float64 {
+	return &topocentricContainer
+}
+
+// Finds topocentric ecliptic longitude, latitude and distance for a planet.
+// Receives:
+//	jd: the Julian day, in UT
+//	φ: geographic latitude, as a unit.Angle
+//	ο: geographic longitude, as a unit.Angle
+//	h: the height above mean sea level, in meters
+//	planet: the required body, as a planetposition constant
+// Returns:
+//	true if the position was found; false on error
+// Notes:
+//	Stores longitude and latitude in degrees, then distance in AU. Use getTopocentricContainer() to retrieve results.
+//	On error, sets ErrMsg and zeroes the container.
+//export findTopocentricPosition
+func findTopocentricPosition(jd float64, φ, ο unit.Angle, h float64, planet int) bool {
+	λ, β, Δ, err := FindTopocentricPosition(jd, φ, ο, h, planet)
+	if err != nil {
+		ErrMsg = err.Error()
+		topocentricContainer = [3]float64{}
+		return false
+	}
+	topocentricContainer = [3]float64{λ.Deg(), β.Deg(), Δ}
+	return true
+}
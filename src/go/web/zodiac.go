@@ -0,0 +1,54 @@
+package web
+
+import (
+	"errors"
+
+	ayanamsa "webeph/ayanamsa"
+	unit "webeph/unit"
+)
+
+// Tropical is the zodiac measured from the equinox, with no ayanamsa.
+const Tropical = -1
+
+var (
+	zodiac = Tropical
+)
+
+// Sets the zodiac of the longitudes found by this package.
+// Receives:
+//	system: Tropical, or an ayanamsa constant for a sidereal zodiac
+// Returns:
+//	err: an error for an unknown ayanamsa, leaving the zodiac unchanged
+// Notes:
+//	FindPosition, FindTopocentricPosition and FindLongitude follow the zodiac, and so do the searches built on them.
+//	Convert house cusps, nodes and stars with ToZodiac. The default is Tropical.
+func SetZodiac(system int) error {
+	if system != Tropical && (system < 0 || system > ayanamsa.UserDefined) {
+		return errors.New("Invalid zodiac.")
+	}
+	zodiac = system
+	return nil
+}
+
+// Gets the zodiac of the longitudes found by this package.
+// Receives:
+//	nothing
+// Returns:
+//	Tropical, or an ayanamsa constant
+func Zodiac() int {
+	return zodiac
+}
+
+// Converts a tropical longitude to the zodiac set by SetZodiac.
+// Receives:
+//	λ: the tropical longitude, as a unit.Angle
+//	jd: the Julian day
+// Returns:
+//	the longitude in the zodiac, as a unit.Angle
+//	err: any errors encountered
+func ToZodiac(λ unit.Angle, jd float64) (unit.Angle, error) {
+	if zodiac == Tropical {
+		return λ, nil
+	}
+	return ayanamsa.Sidereal(λ, zodiac, jd)
+}
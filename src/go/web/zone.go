@@ -0,0 +1,66 @@
+//go:build js && wasm
+
+package web
+
+import (
+	julian "webeph/julian"
+)
+
+var (
+	zoneNameContainer = [64]byte{}
+	zonedJDContainer  = [2]float64{}
+)
+
+// Gets the array that receives the IANA zone name.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for the zone name, as UTF-8 bytes.
+// Notes:
+//	Javascript writes the name here before calling findZonedJD, in place of passing a string.
+//export getZoneNameContainer
+func GetZoneNameContainer() *[64]byte {
+	return &zoneNameContainer
+}
+
+// Gets the array containing the Julian day of a local time.
+// Receives:
+//	nothing
+// Returns:
+//	the address of the storage container for the Julian day and the status.
+// Notes:
+//	Used to send results back to Javascript, in place of the Go runtime's bloated syscall/js functionality.
+//export getZonedJDContainer
+func GetZonedJDContainer() *[2]float64 {
+	return &zonedJDContainer
+}
+
+// Converts a local civil date and time to Julian day.
+// Receives:
+//	y, m, d: the Gregorian year, month (1-12) and day of month
+//	h, min: the hour and minute on the local clock
+//	s: the seconds
+//	nameLength: the length in bytes of the zone name written to getZoneNameContainer
+//	policy: 0 earlier, 1 later or 2 strict, for times in a gap or a fold
+// Returns:
+//	true if the time was converted; false on error, for a name too long, an unknown zone, or a time in a gap or a fold
+//	with the strict policy
+// Notes:
+//	Stores the Julian day in UT, then the status: 0 valid, 1 gap, 2 fold. Use getZonedJDContainer to recover results.
+//	On error, sets ErrMsg and zeroes the container.
+//export findZonedJD
+func findZonedJD(y, m, d, h, min int, s float64, nameLength, policy int) bool {
+	if nameLength < 0 || nameLength > len(zoneNameContainer) {
+		ErrMsg = "Invalid time zone name."
+		zonedJDContainer = [2]float64{}
+		return false
+	}
+	jd, status, err := julian.CalendarZonedToJD(y, m, d, h, min, s, string(zoneNameContainer[:nameLength]), policy)
+	if err != nil {
+		ErrMsg = err.Error()
+		zonedJDContainer = [2]float64{}
+		return false
+	}
+	zonedJDContainer = [2]float64{jd, float64(status)}
+	return true
+}
@@ -1,6 +1,8 @@
 package zabinski
 
 import (
+	"math"
+
 	unit "webeph/unit"
 )
 
@@ -44,3 +46,15 @@ func FindDiff(a, b unit.Angle) float64 {
 	}
 	return bD - aD
 }
+
+// Finds the signed difference between two longitudes.
+// Receives:
+//	a: longitude, as a unit.Angle
+//	b: longitude, as a unit.Angle
+// Returns:
+//	a - b, in degrees, in the range [-180, 180]
+// Notes:
+//	Positive when a lies ahead of b in the order of the signs, by the shorter way around.
+func FindSignedDiff(a, b unit.Angle) float64 {
+	return math.Remainder(a.Deg()-b.Deg(), 360)
+}
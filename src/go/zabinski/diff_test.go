@@ -0,0 +1,26 @@
+package zabinski_test
+
+import (
+	"testing"
+
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+func TestSignedDiff(t *testing.T) {
+	cases := []struct {
+		a, b, expected float64
+	}{
+		{10, 350, 20},
+		{350, 10, -20},
+		{100, 300, 160},
+		{300, 100, -160},
+		{45, 45, 0},
+	}
+	for _, c := range cases {
+		got := zabinski.FindSignedDiff(unit.AngleFromDeg(c.a), unit.AngleFromDeg(c.b))
+		if got-c.expected > 1e-9 || c.expected-got > 1e-9 {
+			t.Errorf("TestSignedDiff: expected %v - %v to be %v, got %v", c.a, c.b, c.expected, got)
+		}
+	}
+}
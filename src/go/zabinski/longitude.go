@@ -3,6 +3,7 @@ package zabinski
 import (
 	"errors"
 
+	deltat "webeph/deltat"
 	elliptic "webeph/elliptic"
 	julian "webeph/julian"
 	nutation "webeph/nutation"
@@ -88,6 +89,9 @@ func FindSiderealTime(Δψ, Δε unit.Angle, jd float64, ο unit.Angle) unit.Tim
 // Returns:
 //	λ: the topocentric ecliptic longitude, as a unit.Angle
 //	err: any errors encountered
+// Notes:
+//	The date is in UT, in the calendar in force on that date: see julian.SetReform.
+//	Theories are evaluated at JDE = UT + ΔT; sidereal time stays in UT.
 func FindLongitude(y, m int, t float64, φ, ο unit.Angle, h float64, planet string, test bool) (λ unit.Angle, err error) {
 	pl := Planets[planet]
 	if pl == "" {
@@ -102,11 +106,15 @@ func FindLongitude(y, m int, t float64, φ, ο unit.Angle, h float64, planet str
 	if err != nil {
 		return 0., err
 	}
-	jd := julian.CalendarGregorianToJD(y, m, t)
-	Δψ, Δε := nutation.Nutation(jd)
-	ε := FindObliquity(Δε, jd)
+	jd, err := julian.CalendarToJD(y, m, t)
+	if err != nil {
+		return 0., err
+	}
+	jde := deltat.JDE(jd)
+	Δψ, Δε := nutation.Nutation(jde)
+	ε := FindObliquity(Δε, jde)
 	lst := FindSiderealTime(Δψ, Δε, jd, ο)
-	geocentricλ, geocentricβ, geocentricΔ := elliptic.EclipticPosition(plData, earth, jd, test, Δψ)
+	geocentricλ, geocentricβ, geocentricΔ := elliptic.EclipticPosition(plData, earth, jde, test, Δψ)
 	horizPx := parallax.Horizontal(geocentricΔ)
 	λ = parallax.TopocentricLongitude(geocentricλ, geocentricβ, φ, h, ε, lst, horizPx)
 	return
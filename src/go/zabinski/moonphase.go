@@ -4,13 +4,14 @@ import (
 	"math"
 
 	base "webeph/base"
+	deltat "webeph/deltat"
 	moonposition "webeph/moonposition"
 	solar "webeph/solar"
 )
 
 // Finds the lunar phase, expressed as the difference between solar and lunar geocentric ecliptic longitude.
 // Receives:
-//	jd: the Julian day
+//	jd: the Julian day, in UT
 // Returns:
 //	the difference between solar and lunar longitude, in degrees. Rounds to the nearest degree.
 // Notes:
@@ -21,8 +22,9 @@ import (
 //	181-359 waning
 //export findMoonPhase
 func FindMoonPhase(jd float64) float64 {
-	solarGeocentricλ := solar.ApparentLongitude(base.J2000Century(jd))
-	lunarGeocentricλ, _, _ := moonposition.Position(jd)
+	jde := deltat.JDE(jd)
+	solarGeocentricλ := solar.ApparentLongitude(base.J2000Century(jde))
+	lunarGeocentricλ, _, _ := moonposition.Position(jde)
 	diff := math.Round(lunarGeocentricλ.Deg() - solarGeocentricλ.Deg())
 	if diff < 0 {
 		diff += 360
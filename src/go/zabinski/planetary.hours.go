@@ -0,0 +1,80 @@
+package zabinski
+
+import (
+	"errors"
+	"math"
+
+	pp "webeph/planetposition"
+	unit "webeph/unit"
+)
+
+// PlanetaryHour is one of the 24 unequal hours of a planetary day.
+type PlanetaryHour struct {
+	Start float64 // Julian day, in UT
+	End   float64 // Julian day, in UT
+	Ruler int     // planetposition constant of the ruling planet
+}
+
+// The seven traditional planets in Chaldean order, from the slowest to the fastest.
+var Chaldean = [7]int{pp.Saturn, pp.Jupiter, pp.Mars, pp.Sun, pp.Venus, pp.Mercury, pp.Moon}
+
+// Day rulers, from Sunday. Each is the ruler of the first hour of its day.
+var dayRulers = [7]int{pp.Sun, pp.Moon, pp.Mars, pp.Mercury, pp.Jupiter, pp.Venus, pp.Saturn}
+
+// Finds the planetary hours of a local date.
+// Receives:
+//	jde: the Julian day
+//	φ: the geographic latitude, as a unit.Angle
+//	ο: the geographic longitude
+// Returns:
+//	hours: the twelve day hours from sunrise to sunset, then the twelve night hours to the next sunrise
+//	dayRuler: the planetposition constant of the day ruler
+//	err: any errors encountered
+// Notes:
+//	The local date is the one jde falls on in local mean time, so an evening west of Greenwich, already the next date
+//	in UT, still gets the hours of that evening's date. The planetary day runs from sunrise to sunrise, so the night
+//	hours run past midnight into the next date.
+//	The day ruler follows the weekday of the local date; each hour after the first takes the next planet in Chaldean order.
+//	Where the Sun does not rise or set, as in polar summer and winter, there are no unequal hours and an error is returned.
+func FindPlanetaryHours(jde float64, φ, ο unit.Angle) (hours [24]PlanetaryHour, dayRuler int, err error) {
+	noon := localMeanDate(jde, ο)
+	// FindSunTimes takes 0ʰ UT as the start of its date.
+	today := FindSunTimes(noon-.5, φ, ο, nil)
+	tomorrow := FindSunTimes(noon+.5, φ, ο, nil)
+	if today.Rise == 0 || today.Set == 0 || tomorrow.Rise == 0 {
+		return hours, 0, errors.New("The Sun does not rise and set on this day.")
+	}
+	// Julian days begin at noon; the day number of the date plus one, modulo 7, is 0 on Sunday.
+	weekday := int(math.Floor(noon+1.5)) % 7
+	dayRuler = dayRulers[weekday]
+	first := 0
+	for i, planet := range Chaldean {
+		if planet == dayRuler {
+			first = i
+		}
+	}
+	dayHour := (today.Set - today.Rise) / 12
+	nightHour := (tomorrow.Rise - today.Set) / 12
+	for i := range hours {
+		start := today.Rise + float64(i)*dayHour
+		length := dayHour
+		if i >= 12 {
+			start = today.Set + float64(i-12)*nightHour
+			length = nightHour
+		}
+		hours[i] = PlanetaryHour{Start: start, End: start + length, Ruler: Chaldean[(first+i)%7]}
+	}
+	// Close the last hour exactly on the next sunrise.
+	hours[23].End = tomorrow.Rise
+	return hours, dayRuler, nil
+}
+
+// Finds the date that jde falls on in local mean time.
+// Receives:
+//	jde: the Julian day
+//	ο: the geographic longitude
+// Returns:
+//	the Julian day at noon UT of the date
+func localMeanDate(jde float64, ο unit.Angle) float64 {
+	return math.Floor(jde + ο.Deg()/360 + .5)
+}
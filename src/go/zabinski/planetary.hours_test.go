@@ -0,0 +1,74 @@
+package zabinski_test
+
+import (
+	"testing"
+
+	julian "webeph/julian"
+	pp "webeph/planetposition"
+	testutils "webeph/testutils"
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+func TestPlanetaryHours(t *testing.T) {
+	// Noon on Friday, 2022 February 11, in Boston: Venus rules the day and its first hour.
+	jde := julian.CalendarGregorianToJD(2022, 2, 11.5+5./24)
+	φ := unit.AngleFromDeg(42.0028761)
+	ο := unit.AngleFromDeg(-71.5147839)
+	hours, dayRuler, err := zabinski.FindPlanetaryHours(jde, φ, ο)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dayRuler != pp.Venus || hours[0].Ruler != pp.Venus {
+		t.Errorf("day ruler %d, first hour %d, want Venus", dayRuler, hours[0].Ruler)
+	}
+	zabinski.FindSunRiseSet(julian.CalendarGregorianToJD(2022, 2, 11), φ, ο)
+	sun := zabinski.GetSunRiseSetPtr()
+	if hours[0].Start != sun[0] || hours[12].Start != sun[1] {
+		t.Errorf("hours begin at %v and %v, want sunrise %v and sunset %v", hours[0].Start, hours[12].Start, sun[0], sun[1])
+	}
+	for i := 1; i < len(hours); i++ {
+		if !testutils.CheckTolerance(hours[i].Start, hours[i-1].End, 1e-9) {
+			t.Errorf("hour %d starts at %v, hour %d ends at %v", i, hours[i].Start, i-1, hours[i-1].End)
+		}
+	}
+	// Winter days are short: day hours are shorter than night hours.
+	if hours[0].End-hours[0].Start >= hours[12].End-hours[12].Start {
+		t.Error("day hours should be shorter than night hours in February")
+	}
+	// The night runs into Saturday, whose first hour belongs to Saturn: 24 hours on from Venus in Chaldean order.
+	next, nextRuler, err := zabinski.FindPlanetaryHours(jde+1, φ, ο)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nextRuler != pp.Saturn || !testutils.CheckTolerance(next[0].Start, hours[23].End, 1e-9) {
+		t.Errorf("next day ruler %d starting %v, want Saturn at %v", nextRuler, next[0].Start, hours[23].End)
+	}
+	if hours[23].Ruler != pp.Moon {
+		t.Errorf("last hour ruler %d, want the Moon", hours[23].Ruler)
+	}
+}
+
+func TestPlanetaryHoursEvening(t *testing.T) {
+	// 8 PM Eastern Standard Time on Friday is already Saturday in UT; the hours are still Friday's.
+	φ := unit.AngleFromDeg(42.0028761)
+	ο := unit.AngleFromDeg(-71.5147839)
+	noon, _, err := zabinski.FindPlanetaryHours(julian.CalendarGregorianToJD(2022, 2, 11.5+5./24), φ, ο)
+	if err != nil {
+		t.Fatal(err)
+	}
+	evening, dayRuler, err := zabinski.FindPlanetaryHours(julian.CalendarGregorianToJD(2022, 2, 12+1./24), φ, ο)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dayRuler != pp.Venus || evening != noon {
+		t.Errorf("day ruler %d, first hour from %v, want Venus from %v", dayRuler, evening[0].Start, noon[0].Start)
+	}
+}
+
+func TestPlanetaryHoursPolar(t *testing.T) {
+	jde := julian.CalendarGregorianToJD(2022, 6, 21)
+	if _, _, err := zabinski.FindPlanetaryHours(jde, unit.AngleFromDeg(69.65), unit.AngleFromDeg(18.96)); err == nil {
+		t.Error("no error under the midnight Sun")
+	}
+}
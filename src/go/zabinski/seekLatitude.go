@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	deltat "webeph/deltat"
 	julian "webeph/julian"
 	"webeph/moonposition"
 )
@@ -39,7 +40,7 @@ func fractionalDay(tm time.Time) float64 {
 func getLunarLatitude(tm time.Time, c chan LatitudeInfo, wg *sync.WaitGroup) {
 	defer wg.Done()
 	jd := julian.CalendarGregorianToJD(tm.Year(), int(tm.Month()), fractionalDay(tm))
-	_, geocentricβ, _ := moonposition.Position(jd)
+	_, geocentricβ, _ := moonposition.Position(deltat.JDE(jd))
 	info := &LatitudeInfo{
 		β:  geocentricβ.Deg(),
 		tm: tm,
@@ -6,12 +6,13 @@ import (
 	"webeph/apparent"
 	base "webeph/base"
 	coord "webeph/coord"
+	deltat "webeph/deltat"
 	unit "webeph/unit"
 )
 
 // Find geocentric ecliptic longitude for a star.
 // Receives:
-//	jd: Julian day
+//	jd: Julian day, in UT
 //	ε: Obliquity, as a unit.Angle
 //	raH: Right ascension hours
 //	raM: Right ascension minutes
@@ -31,6 +32,8 @@ import (
 //   big difference for the Moon, and a small difference for planets. (2 degrees for the Moon, and only 8 seconds at maximum for
 //   planets.) Seeing that we are dealing with a fraction of a degree for the planets, and the stars are much farther away, the
 //   difference is likely so small that it is neglected.
+//	3. Annual parallax and radial velocity are left out, and latitude is not returned. For those, and for stars near the poles
+//	 or with large proper motion over long spans, see stars.Star.Apparent.
 //export findStellarLongitude
 func FindStellarLongitude(jd float64, ε unit.Angle, raH, raM int, raS float64, declD, declM int, declS, raμ, declμ float64) float64 {
 	declSign := '-'
@@ -43,7 +46,7 @@ func FindStellarLongitude(jd float64, ε unit.Angle, raH, raM int, raS float64,
 		Dec: unit.NewAngle(byte(declSign), declD, declM, declS),
 	}
 	epochFrom := 2000.0
-	epochTo := base.JDEToJulianYear(jd)
+	epochTo := base.JDEToJulianYear(deltat.JDE(jd))
 	// Find the geocentric position of the star. (apparent.Position takes care of the heliocentric position first.)
 	eqTo := apparent.Position(
 		eq,
@@ -4,12 +4,19 @@ import (
 	"math"
 
 	base "webeph/base"
+	coord "webeph/coord"
+	deltat "webeph/deltat"
+	globe "webeph/globe"
+	parallax "webeph/parallax"
+	rise "webeph/rise"
+	solar "webeph/solar"
 	unit "webeph/unit"
 )
 
 var (
 	sunRiseSet = [2]float64{}
-	circle     = unit.Angle(2 * math.Pi)
+	// Semidiameter of the Sun at 1 AU, Meeus p. 389.
+	sunSemidiameter = unit.AngleFromSec(959.63)
 )
 
 // Retrieves the pointer where sunrise and sunset are stored.
@@ -34,29 +41,68 @@ func GetSunRiseSetPtr() *[2]float64 {
 //	nothing
 // Notes:
 //	Stores the calculation in a private, package-level variable. Retrieve results by calling GetSunRiseSetPtr.
-//	Developed from the spec in https://en.wikipedia.org/wiki/Sunrise_equation.
+//	Sunrise and sunset are those of the local date, found with rise.Find on the standard horizon. Sunrise or sunset is
+//	zero when it does not happen; FindSunTimes tells whether the Sun then stays up or down.
 //export findSunRiseSet
 func FindSunRiseSet(jde float64, φ, ο unit.Angle) {
-	// 1. Find Julian day since the year 2000.
-	currJulianDay := math.Ceil(jde - base.J2000 + 0.0008)
-	// 2. Find mean solar time.
-	meanSolarTime := currJulianDay - ο.Div(circle.Rad()).Rad()
-	// 3. Find solar anomaly.
-	m := unit.Angle((0.01720196999454 * meanSolarTime) + 6.2400599667).Mod1()
-	m2 := unit.Angle(2 * m).Mod1()
-	m3 := unit.Angle(3 * m).Mod1()
-	// 4. Find equation of the center.
-	eqCenter := (1.9148 * m.Sin()) + (0.02 * m2.Sin()) + (0.0003 * m3.Sin())
-	// 5. Find ecliptic longitude of the Sun.
-	λ := unit.AngleFromDeg(m.Deg() + eqCenter + 180 + 102.9372).Mod1()
-	λ2 := λ.Mul(2).Mod1()
-	// 6. Find solar noon.
-	transit := base.J2000 + meanSolarTime + (0.0053 * m.Sin()) - (0.0069 * λ2.Sin())
-	// 7. Find declination.
-	δ := unit.Angle(math.Asin(λ.Sin() * 0.39778850739795))
-	// 8. Find solar hour angle.
-	hourAngle := unit.Angle(math.Acos((-0.014485726138606 - (φ.Sin() * δ.Sin())) / (φ.Cos() * δ.Cos())))
-	// 9. Sunrise and sunset are always equal time from noon. Use the solar hour angle to calculate the number of hours from noon.
-	hours := hourAngle.Div(circle.Rad()).Rad()
-	sunRiseSet = [2]float64{transit - hours, transit + hours}
+	times := FindSunTimes(jde, φ, ο, nil)
+	sunRiseSet = [2]float64{times.Rise, times.Set}
 }
+
+// Finds the Sun's rising, transit and setting on a local date.
+// Receives:
+//	jde: the Julian day
+//	φ: the geographic latitude, as a unit.Angle
+//	ο: the geographic longitude
+//	horizon: the horizon, or nil for rise.Standard
+// Returns:
+//	times: the events of the local date
+// Notes:
+//	Sunrise is times.Rise and sunset times.Set. A horizon with an altitude below zero gives dawn and dusk, as FindSunAltitude does.
+func FindSunTimes(jde float64, φ, ο unit.Angle, horizon *rise.Horizon) rise.Times {
+	// Local mean noon of the date. The day runs from the local midnight before it.
+	noon := localDate(jde) - ο.Deg()/360
+	// globe.Coord measures longitude westward.
+	return rise.Find(sunPosition, noon-.5, &globe.Coord{Lat: φ, Lon: -ο}, horizon)
+}
+
+// Finds the date that sunrise and sunset belong to.
+// Receives:
+//	jde: the Julian day
+// Returns:
+//	the Julian day at noon UT of the date
+func localDate(jde float64) float64 {
+	return base.J2000 + math.Ceil(jde-base.J2000+0.0008)
+}
+
+// Builds the place of a body for rise.Find from its ecliptic place.
+// Receives:
+//	ecliptic: the geocentric longitude, latitude, distance in AU and equatorial horizontal parallax, by Julian ephemeris day
+//	semidiameter: the semidiameter, from the distance and the parallax
+// Returns:
+//	the place of the body, as a function of the Julian day in UT
+// Notes:
+//	The ecliptic place is turned to right ascension and declination with the mean obliquity of date, leaving out nutation
+//	as FindObliquity(0, jde) does.
+func RisePosition(ecliptic func(jde float64) (λ, β unit.Angle, Δ float64, π unit.Angle),
+	semidiameter func(Δ float64, π unit.Angle) unit.Angle) rise.Position {
+	return func(jd float64) (α unit.RA, δ, π, s unit.Angle) {
+		jde := deltat.JDE(jd)
+		λ, β, Δ, π := ecliptic(jde)
+		sε, cε := FindObliquity(0, jde).Sincos()
+		α, δ = coord.EclToEq(λ, β, sε, cε)
+		return α, δ, π, semidiameter(Δ, π)
+	}
+}
+
+// The place of the Sun for rise.Find.
+var sunPosition = RisePosition(
+	func(jde float64) (λ, β unit.Angle, Δ float64, π unit.Angle) {
+		T := base.J2000Century(jde)
+		R := solar.Radius(T)
+		return solar.ApparentLongitude(T), 0, R, parallax.Horizontal(R)
+	},
+	func(Δ float64, π unit.Angle) unit.Angle {
+		return sunSemidiameter.Div(Δ)
+	},
+)
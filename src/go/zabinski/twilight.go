@@ -0,0 +1,66 @@
+package zabinski
+
+import (
+	rise "webeph/rise"
+	unit "webeph/unit"
+)
+
+// Altitudes of the Sun's center at the ends of twilight.
+var (
+	CivilTwilight        = unit.AngleFromDeg(-6)
+	NauticalTwilight     = unit.AngleFromDeg(-12)
+	AstronomicalTwilight = unit.AngleFromDeg(-18)
+)
+
+var (
+	twilight = [3]float64{}
+)
+
+// Retrieves the pointer where dawn and dusk are stored.
+// Receives:
+//	nothing
+// Returns:
+//	a pointer to dawn, dusk and status
+// Notes:
+//	This is done to support the indirection used by TinyGo in WASM when more than one value needs to be produced at a time.
+//export getTwilightPtr
+func GetTwilightPtr() *[3]float64 {
+	return &twilight
+}
+
+// Calculates the times of dawn and dusk for an altitude of the Sun.
+// Receives:
+//	jde: the Julian day
+//	φ: the geographic latitude, as a unit.Angle
+//	ο: the geographic longitude
+//	altitude: the altitude of the Sun's center, as a unit.Angle. See CivilTwilight, NauticalTwilight and AstronomicalTwilight.
+// Returns:
+//	nothing
+// Notes:
+//	Stores the calculation in a private, package-level variable. Retrieve results by calling GetTwilightPtr.
+//	See FindSunAltitude for the values stored.
+//export findTwilight
+func FindTwilight(jde float64, φ, ο, altitude unit.Angle) {
+	dawn, dusk, status := FindSunAltitude(jde, φ, ο, altitude)
+	twilight = [3]float64{dawn, dusk, float64(status)}
+}
+
+// Finds when the Sun's center crosses an altitude on a local date.
+// Receives:
+//	jde: the Julian day
+//	φ: the geographic latitude, as a unit.Angle
+//	ο: the geographic longitude
+//	altitude: the altitude of the Sun's center, as a unit.Angle
+// Returns:
+//	dawn: the Julian day when the Sun rises through the altitude, or zero
+//	dusk: the Julian day when the Sun sinks through the altitude, or zero
+//	status: a rise status constant
+// Notes:
+//	The altitude is geometric: no refraction or semidiameter is applied, as is usual for twilight.
+//	Status rise.Circumpolar means the Sun never sinks below the altitude, so that twilight never ends:
+//	astronomical twilight around the summer solstice in London, for example.
+//	Status rise.NeverRises means the Sun never reaches the altitude, so that the day has no such twilight.
+func FindSunAltitude(jde float64, φ, ο, altitude unit.Angle) (dawn, dusk float64, status int) {
+	times := FindSunTimes(jde, φ, ο, &rise.Horizon{Altitude: altitude})
+	return times.Rise, times.Set, times.Status
+}
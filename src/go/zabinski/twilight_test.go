@@ -0,0 +1,48 @@
+package zabinski_test
+
+import (
+	"testing"
+
+	julian "webeph/julian"
+	rise "webeph/rise"
+	unit "webeph/unit"
+	zabinski "webeph/zabinski"
+)
+
+func TestTwilightOrder(t *testing.T) {
+	jde := julian.CalendarGregorianToJD(2022, 2, 11)
+	φ := unit.AngleFromDeg(42.0028761)
+	ο := unit.AngleFromDeg(-71.5147839)
+	zabinski.FindSunRiseSet(jde, φ, ο)
+	sun := *zabinski.GetSunRiseSetPtr()
+	last := [2]float64{sun[0], sun[1]}
+	for _, altitude := range []unit.Angle{zabinski.CivilTwilight, zabinski.NauticalTwilight, zabinski.AstronomicalTwilight} {
+		dawn, dusk, status := zabinski.FindSunAltitude(jde, φ, ο, altitude)
+		if status != rise.Normal {
+			t.Fatalf("%v°: status %d", altitude.Deg(), status)
+		}
+		// At this latitude in February each stage of twilight lasts about half an hour.
+		if d := last[0] - dawn; d < 20./1440 || d > 40./1440 {
+			t.Errorf("%v°: dawn %v, %v minutes before %v", altitude.Deg(), JDToDateString(dawn, -5), d*1440, JDToDateString(last[0], -5))
+		}
+		if d := dusk - last[1]; d < 20./1440 || d > 40./1440 {
+			t.Errorf("%v°: dusk %v, %v minutes after %v", altitude.Deg(), JDToDateString(dusk, -5), d*1440, JDToDateString(last[1], -5))
+		}
+		last = [2]float64{dawn, dusk}
+	}
+}
+
+func TestTwilightNeverEnds(t *testing.T) {
+	// At midsummer the Sun sinks only to about -15° in London: nautical twilight ends, astronomical never does.
+	jde := julian.CalendarGregorianToJD(2022, 6, 21)
+	φ := unit.AngleFromDeg(51.5074)
+	ο := unit.AngleFromDeg(-.1278)
+	if _, _, status := zabinski.FindSunAltitude(jde, φ, ο, zabinski.NauticalTwilight); status != rise.Normal {
+		t.Errorf("nautical twilight: status %d, want %d", status, rise.Normal)
+	}
+	zabinski.FindTwilight(jde, φ, ο, zabinski.AstronomicalTwilight)
+	ptr := zabinski.GetTwilightPtr()
+	if ptr[0] != 0 || ptr[1] != 0 || ptr[2] != rise.Circumpolar {
+		t.Errorf("astronomical twilight: %v, want never ending", *ptr)
+	}
+}
@@ -3,10 +3,14 @@ import { Injectable } from '@angular/core';
 import { Resolve } from '@angular/router';
 import { from, Observable, of } from 'rxjs';
 import { map, switchMap, tap } from 'rxjs/operators';
-import { AstroFns, AngleConversionFn, Geo, LongitudeResult } from '../common';
+import {
+    AstroFns, AngleConversionFn, calendarReforms, Geo, houseSystems, LocalTime, LongitudeResult, LotFormula, zonePolicies
+} from '../common';
 import { makeTinyGoImportObj, goRuntime } from '../tinygo';
 
 const sizeOfFloat64 = 8;
+// The size, in bytes, of the Go containers that receive names.
+const sizeOfName = 64;
 
 interface TinyGoExport extends WebAssembly.Exports {
     memory: WebAssembly.Memory;
@@ -34,10 +38,45 @@ interface TinyGoExport extends WebAssembly.Exports {
     findSunRiseSet: (jde: number, φ: number, ο: number) => void;
     getObliquityLSTContainer: () => number;
     findObliquityLST: (jd: number, ο: number) => void;
-    getHouseContainer: () => number;
-    findHouses: (lst: number, ε: number, φ: number) => void;
+    getHouseContainer: (system: number) => number;
+    findHouses: (lst: number, ε: number, φ: number, system: number) => number;
     getTimeContainer: () => number;
     jdToCalendar: (jd: number) => void;
+    setCalendarReform: (jd: number) => void;
+    getZoneNameContainer: () => number;
+    getZonedJDContainer: () => number;
+    findZonedJD: (y: number, m: number, d: number, h: number, min: number, s: number, nameLength: number, policy: number) => number;
+    setZodiac: (system: number) => void;
+    setUserAyanamsa: (epoch: number, value: number) => void;
+    findAyanamsa: (jd: number, system: number) => number;
+    toZodiac: (λ: number, jd: number) => number;
+    getLotsContainer: () => number;
+    findLots: (jd: number, φ: number, ο: number, h: number) => number;
+    getFormulaContainer: () => number;
+    getCustomLotsContainer: () => number;
+    findCustomLots: (jd: number, φ: number, ο: number, h: number, count: number) => number;
+    getStarNameContainer: () => number;
+    getStarPositionContainer: () => number;
+    getStarCount: () => number;
+    findStarIndex: (nameLength: number) => number;
+    findStarName: (index: number) => number;
+    findStarPosition: (index: number, jd: number) => number;
+    findStarHeliacal: (event: number, jd: number, index: number, av: number, φ: number, ο: number, h: number) => number;
+    findPlanetHeliacal: (event: number, jd: number, planet: number, av: number, φ: number, ο: number, h: number) => number;
+    getReturnContainer: () => number;
+    findReturn: (natalJD: number, jdStart: number, φ: number, ο: number, h: number, planet: number, system: number, mode: number) => number;
+    getTopocentricContainer: () => number;
+    findTopocentricPosition: (jd: number, φ: number, ο: number, h: number, planet: number) => number;
+    getHorizontalContainer: () => number;
+    findHorizontalPosition: (jd: number, φ: number, ο: number, h: number, planet: number) => number;
+    getSpeedContainer: () => number;
+    findSpeed: (jd: number, planet: number) => number;
+    getRiseSetContainer: () => number;
+    findRiseTransitSet: (jd: number, φ: number, ο: number, h: number, planet: number) => number;
+    getTwilightPtr: () => number;
+    findTwilight: (jde: number, φ: number, ο: number, altitude: number) => void;
+    getPlanetaryHoursContainer: () => number;
+    findPlanetaryHours: (jde: number, φ: number, ο: number) => number;
 }
 
 @Injectable()
@@ -68,6 +107,43 @@ export class EphLoader implements Resolve<AstroFns> {
                         this.wasmFindHouses = exported.findHouses;
                         this.wasmGetTimeContainer = exported.getTimeContainer;
                         this.wasmJdToCalendar = exported.jdToCalendar;
+                        this.wasmSetCalendarReform = exported.setCalendarReform;
+                        this.wasmGetZoneNameContainer = exported.getZoneNameContainer;
+                        this.wasmGetZonedJDContainer = exported.getZonedJDContainer;
+                        this.wasmFindZonedJD = exported.findZonedJD;
+                        this.wasmSetZodiac = exported.setZodiac;
+                        this.wasmSetUserAyanamsa = exported.setUserAyanamsa;
+                        this.wasmFindAyanamsa = exported.findAyanamsa;
+                        this.wasmToZodiac = exported.toZodiac;
+                        this.wasmGetLotsContainer = exported.getLotsContainer;
+                        this.wasmFindLots = exported.findLots;
+                        this.wasmGetFormulaContainer = exported.getFormulaContainer;
+                        this.wasmGetCustomLotsContainer = exported.getCustomLotsContainer;
+                        this.wasmFindCustomLots = exported.findCustomLots;
+                        this.wasmGetStarNameContainer = exported.getStarNameContainer;
+                        this.wasmGetStarPositionContainer = exported.getStarPositionContainer;
+                        this.wasmGetStarCount = exported.getStarCount;
+                        this.wasmFindStarIndex = exported.findStarIndex;
+                        this.wasmFindStarName = exported.findStarName;
+                        this.wasmFindStarPosition = exported.findStarPosition;
+                        this.wasmFindStarHeliacal = exported.findStarHeliacal;
+                        this.wasmFindPlanetHeliacal = exported.findPlanetHeliacal;
+                        this.wasmGetReturnContainer = exported.getReturnContainer;
+                        this.wasmFindReturn = exported.findReturn;
+                        this.wasmGetTopocentricContainer = exported.getTopocentricContainer;
+                        this.wasmFindTopocentricPosition = exported.findTopocentricPosition;
+                        this.wasmGetHorizontalContainer = exported.getHorizontalContainer;
+                        this.wasmFindHorizontalPosition = exported.findHorizontalPosition;
+                        this.wasmGetSpeedContainer = exported.getSpeedContainer;
+                        this.wasmFindSpeed = exported.findSpeed;
+                        this.wasmGetRiseSetContainer = exported.getRiseSetContainer;
+                        this.wasmFindRiseTransitSet = exported.findRiseTransitSet;
+                        this.wasmGetTwilightPtr = exported.getTwilightPtr;
+                        this.wasmFindTwilight = exported.findTwilight;
+                        this.wasmGetPlanetaryHoursContainer = exported.getPlanetaryHoursContainer;
+                        this.wasmFindPlanetaryHours = exported.findPlanetaryHours;
+                        // Moment uses the proleptic Gregorian calendar, so dates passed in and out must too, until setCalendarReform.
+                        exported.setCalendarReform(calendarReforms.prolepticGregorian);
                     }),
                     tap(() => this.initialized = true),
                     map(() => this.resolveLib())
@@ -87,7 +163,26 @@ export class EphLoader implements Resolve<AstroFns> {
             findSunRiseSet: this.findSunRiseSet,
             findObliquityLST: this.findObliquityLST,
             findHouses: this.findHouses,
-            jdToMoment: this.jdToMoment
+            jdToMoment: this.jdToMoment,
+            setCalendarReform: this.setCalendarReform,
+            findZonedJD: this.findZonedJD,
+            setZodiac: this.setZodiac,
+            setUserAyanamsa: this.setUserAyanamsa,
+            findAyanamsa: this.findAyanamsa,
+            findLots: this.findLots,
+            findCustomLots: this.findCustomLots,
+            findStarIndex: this.findStarIndex,
+            findStarNames: this.findStarNames,
+            findStarPosition: this.findStarPosition,
+            findStarHeliacal: this.findStarHeliacal,
+            findPlanetHeliacal: this.findPlanetHeliacal,
+            findReturn: this.findReturn,
+            findTopocentricPosition: this.findTopocentricPosition,
+            findHorizontalPosition: this.findHorizontalPosition,
+            findSpeed: this.findSpeed,
+            findRiseTransitSet: this.findRiseTransitSet,
+            findTwilight: this.findTwilight,
+            findPlanetaryHours: this.findPlanetaryHours
         };
     }
 
@@ -98,7 +193,7 @@ export class EphLoader implements Resolve<AstroFns> {
     //  the angle, as a TinyGo unit.Angle (always in radians)
     findAngleFromDeg = (measure: number): number => this.wasmFindAngleFromDeg(measure);
 
-    // Finds the topocentric ecliptic longitude of a planet, in degrees.
+    // Finds the topocentric ecliptic longitude of a planet, in degrees, in the zodiac set by setZodiac.
     // Receives:
     //  mmt: the interesting Moment
     //  coords: coordinates of the observer, as a Geo interface
@@ -148,7 +243,8 @@ export class EphLoader implements Resolve<AstroFns> {
     //  the ascending node, in degrees.
     // Notes:
     //  Find the opposite angle 180˚ away for the descending node.
-    findAscendingNode = (jd: number): number => this.wasmFindAscendingNode(jd);
+    //  Follows the zodiac set by setZodiac.
+    findAscendingNode = (jd: number): number => this.toZodiac(this.wasmFindAscendingNode(jd), jd);
 
     // Finds the lunar phase, expressed as the difference between solar and lunar geocentric ecliptic longitude.
     // Receives:
@@ -176,7 +272,7 @@ export class EphLoader implements Resolve<AstroFns> {
     //	raμ: Change in right ascension, in arc seconds per year
     //	declμ: Change in declination, in arc seconds per year
     // Returns:
-    //	geocentric ecliptic longitude, in degrees
+    //	geocentric ecliptic longitude, in degrees, in the zodiac set by setZodiac
     // Notes:
     //	1. The parameters are as granular as they are because star catalogs break it down this way. As we come closer to calculating
     //	 fixed star elections, we may change to decimal degrees for both measures, depending on what we can do with the star catalog.
@@ -185,6 +281,8 @@ export class EphLoader implements Resolve<AstroFns> {
     //   big difference for the Moon, and a small difference for planets. (2 degrees for the Moon, and only 8 seconds at maximum for
     //   planets.) Seeing that we are dealing with a fraction of a degree for the planets, and the stars are much farther away, the
     //   difference is likely so small that it is neglected.
+    //	3. For the stars of the embedded catalog, use findStarIndex and findStarPosition instead of a table of your own.
+    //	 Keep this function for stars the catalog does not hold.
     findStar = (jd: number,
         ε: number,
         raH: number,
@@ -194,14 +292,15 @@ export class EphLoader implements Resolve<AstroFns> {
         declM: number,
         declS: number,
         raμ: number,
-        declμ: number): number => this.wasmFindStellarLongitude(jd, ε, raH, raM, raS, declD, declM, declS, raμ, declμ);
+        declμ: number): number =>
+        this.toZodiac(this.wasmFindStellarLongitude(jd, ε, raH, raM, raS, declD, declM, declS, raμ, declμ), jd);
 
     // Finds sunrise and sunset for a given day.
     // Receives:
     //  jd: a Julian day
     //  coord: a Geo interface representing the observer's geographic coordinates
     // Returns:
-    //  an array, where sunrise is provided first, then sunset
+    //  an array, where sunrise is provided first, then sunset; either is 0 if it does not happen on the day
     findSunRiseSet = (jd: number, coord: Geo): Array<number> => {
         const φ = this.wasmFindAngleFromDeg(coord.φ);
         const ο = this.wasmFindAngleFromDeg(coord.ο);
@@ -229,20 +328,26 @@ export class EphLoader implements Resolve<AstroFns> {
         return Array.from(memView);
     };
 
-    // Finds Regiomontanus houses.
+    // Finds houses.
     // Receives:
     //  lst: local sidereal time, in radians
     //  ε: obliquity, in radians
     //  coord: a Geo interface representing the observer's geographic coordinates
+    //  system: the house system, from houseSystems. Defaults to Regiomontanus.
+    //  jd: the Julian day. Required for the houses to follow the zodiac set by setZodiac; without it they stay tropical.
     // Returns:
-    //  an array of the houses in order, measured in degrees.
-    findHouses = (lst: number, ε: number, coord: Geo): Array<number> => {
+    //  an array of the houses in order, measured in degrees, or an empty array if the system cannot be cast at the latitude
+    findHouses = (lst: number, ε: number, coord: Geo, system = houseSystems.regiomontanus, jd?: number): Array<number> => {
         const φ = this.wasmFindAngleFromDeg(coord.φ);
-        this.wasmFindHouses(lst, ε, φ);
-        const begin = this.wasmGetHouseContainer();
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindHouses(lst, ε, φ, system) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetHouseContainer(system);
         const end = begin + (sizeOfFloat64 * 12);
         const memView = new Float64Array(this.memory.buffer.slice(begin, end));
-        return Array.from(memView);
+        const cusps = Array.from(memView);
+        return jd === undefined ? cusps : cusps.map(cusp => this.toZodiac(cusp, jd));
     };
 
     // Converts a Julian day to the equivalent Moment.
@@ -258,19 +363,362 @@ export class EphLoader implements Resolve<AstroFns> {
         const end = begin + (sizeOfInt32 * 6);
         const memView = new Int32Array(this.memory.buffer.slice(begin, end));
         const [y, m, d, hr, mn, sc] = Array.from(memView);
-        let mmt: Moment;
-        // Very rarely, sc will be precisely 60. Moment can't handle that.
-        if (sc === 60) {
-            // Create the time, omitting seconds, then add the seconds to the existing time.
-            mmt = moment.utc([y, m, d, hr, mn]);
-            mmt.add(sc, 'seconds');
-        } else {
-            mmt = moment.utc([y, m, d, hr, mn, sc]);
-        }
+        const mmt = moment.utc([y, m, d, hr, mn, sc]);
         mmt.utcOffset(offset);
         return mmt;
     };
 
+    // Sets the calendar in which dates are read and written.
+    // Receives:
+    //  reform: the Julian day of the first Gregorian date, from calendarReforms
+    // Returns:
+    //  nothing
+    // Notes:
+    //  Applies to findLongitude and jdToMoment, which read and write the fields of a Moment as a date in the calendar in force:
+    //  Julian before the reform, Gregorian from it on. The default is prolepticGregorian, the calendar of Moment itself.
+    //  findJD always reads a Moment as Gregorian.
+    setCalendarReform = (reform: number): void => this.wasmSetCalendarReform(reform);
+
+    // Finds the Julian day for a local date and time in an IANA time zone.
+    // Receives:
+    //  local: the date and time on the local clock
+    //  zone: the IANA zone name, such as 'America/New_York'
+    //  policy: the reading of times skipped or repeated by a clock change, from zonePolicies. Defaults to earlier.
+    // Returns:
+    //  an array, where the Julian day in UT is provided first, then the status, from zoneStatuses;
+    //  an empty array if the zone name is longer than 64 bytes, if the zone is unknown, or if the policy is strict and the time
+    //  is skipped or repeated by a clock change
+    // Notes:
+    //  The zone name is written into WASM linear memory, in place of passing a string.
+    findZonedJD = (local: LocalTime, zone: string, policy = zonePolicies.earlier): Array<number> => {
+        const name = new TextEncoder().encode(zone);
+        if (name.length > sizeOfName) {
+            return [];
+        }
+        const nameBegin = this.wasmGetZoneNameContainer();
+        new Uint8Array(this.memory.buffer, nameBegin, name.length).set(name);
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindZonedJD(local.y, local.m, local.d, local.h, local.min, local.s, name.length, policy) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetZonedJDContainer();
+        const end = begin + (sizeOfFloat64 * 2);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Sets the zodiac of the longitudes found: tropical, or sidereal with an ayanamsa.
+    // Receives:
+    //  zodiac: the zodiac, from zodiacs
+    // Returns:
+    //  nothing
+    // Notes:
+    //  Applies to findLongitude, findAscendingNode, findStar, and findHouses when given a Julian day. The default is tropical.
+    setZodiac = (zodiac: number): void => this.wasmSetZodiac(zodiac);
+
+    // Sets the user-defined ayanamsa, zodiacs.userDefined.
+    // Receives:
+    //  epoch: the Julian day at which the value holds
+    //  value: the ayanamsa at the epoch, in degrees
+    // Returns:
+    //  nothing
+    // Notes:
+    //  The ayanamsa then moves with precession.
+    setUserAyanamsa = (epoch: number, value: number): void => this.wasmSetUserAyanamsa(epoch, this.wasmFindAngleFromDeg(value));
+
+    // Finds an ayanamsa, in degrees.
+    // Receives:
+    //  jd: a Julian day
+    //  zodiac: the sidereal zodiac, from zodiacs
+    // Returns:
+    //  the ayanamsa, to be subtracted from a tropical longitude
+    findAyanamsa = (jd: number, zodiac: number): number => this.wasmFindAyanamsa(jd, zodiac);
+
+    // Finds the lots of Fortune, Spirit, Eros, Necessity and Marriage, in degrees.
+    // Receives:
+    //  jd: a Julian day
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array of the five lots, in the zodiac set by setZodiac, then 1 for a day chart or 0 for a night chart;
+    //  an empty array on error
+    // Notes:
+    //  By night, each lot is cast the other way round.
+    findLots = (jd: number, coord: Geo): Array<number> => {
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindLots(jd, φ, ο, coord.h) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetLotsContainer();
+        const end = begin + (sizeOfFloat64 * 6);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Finds lots cast from any formulas, in degrees.
+    // Receives:
+    //  jd: a Julian day
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  formulas: up to 32 formulas, each a LotFormula
+    // Returns:
+    //  an array of the lots, in the order of the formulas and in the zodiac set by setZodiac, then 1 for a day chart or 0 for
+    //  a night chart; an empty array for more than 32 formulas, an unknown point, or Pluto outside 1885 to 2099
+    // Notes:
+    //  The formulas are written into WASM linear memory as four 32-bit integers each, in place of passing objects.
+    findCustomLots = (jd: number, coord: Geo, formulas: Array<LotFormula>): Array<number> => {
+        const maxFormulas = 32;
+        if (formulas.length > maxFormulas) {
+            return [];
+        }
+        const packed = formulas.flatMap(f => [f.a, f.b, f.c, f.reverse ? 1 : 0]);
+        new Int32Array(this.memory.buffer, this.wasmGetFormulaContainer(), packed.length).set(packed);
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindCustomLots(jd, φ, ο, coord.h, formulas.length) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetCustomLotsContainer();
+        const end = begin + (sizeOfFloat64 * (formulas.length + 1));
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Finds a star in the catalog by name, Bayer designation or HIP number.
+    // Receives:
+    //  query: a name such as 'Regulus', a Bayer designation such as 'α Leo' or 'alpha Leo', or a HIP number such as 'HIP 49669'
+    // Returns:
+    //  the index of the star in the catalog, or -1 if none matches
+    // Notes:
+    //  The query is written into WASM linear memory, in place of passing a string. No name is longer than 64 bytes.
+    findStarIndex = (query: string): number => {
+        const name = new TextEncoder().encode(query);
+        if (name.length > sizeOfName) {
+            return -1;
+        }
+        const begin = this.wasmGetStarNameContainer();
+        new Uint8Array(this.memory.buffer, begin, name.length).set(name);
+        return this.wasmFindStarIndex(name.length);
+    };
+
+    // Lists the names of the stars in the catalog.
+    // Receives:
+    //  nothing
+    // Returns:
+    //  the names, in catalog order: a star's index is its position in this array
+    findStarNames = (): Array<string> => {
+        const begin = this.wasmGetStarNameContainer();
+        const names: Array<string> = [];
+        for (let i = 0; i < this.wasmGetStarCount(); i++) {
+            const length = this.wasmFindStarName(i);
+            names.push(new TextDecoder().decode(this.memory.buffer.slice(begin, begin + length)));
+        }
+        return names;
+    };
+
+    // Finds the geocentric apparent place of a catalog star, in degrees.
+    // Receives:
+    //  index: the index of the star in the catalog, from findStarIndex
+    //  jd: a Julian day
+    // Returns:
+    //  an array, where longitude is provided first, in the zodiac set by setZodiac, then latitude, right ascension and declination;
+    //  an empty array for an index outside the catalog
+    findStarPosition = (index: number, jd: number): Array<number> => {
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindStarPosition(index, jd) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetStarPositionContainer();
+        const end = begin + (sizeOfFloat64 * 4);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Finds the next heliacal event of a catalog star.
+    // Receives:
+    //  event: the event, from heliacalEvents
+    //  jd: a Julian day to search from
+    //  index: the index of the star in the catalog, from findStarIndex
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  av: the arcus visionis, in degrees; estimated from the star's magnitude when left out
+    // Returns:
+    //  the Julian day of the star's rising or setting on the day of the event, or 0 if none
+    findStarHeliacal = (event: number, jd: number, index: number, coord: Geo, av = 0): number => {
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        return this.wasmFindStarHeliacal(event, jd, index, this.wasmFindAngleFromDeg(av), φ, ο, coord.h);
+    };
+
+    // Finds the next heliacal event of a planet.
+    // Receives:
+    //  event: the event, from heliacalEvents
+    //  jd: a Julian day to search from
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  av: the arcus visionis, in degrees
+    // Returns:
+    //  the Julian day of the planet's rising or setting on the day of the event, or 0 if none
+    findPlanetHeliacal = (event: number, jd: number, planet: number, coord: Geo, av: number): number => {
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        return this.wasmFindPlanetHeliacal(event, jd, planet, this.wasmFindAngleFromDeg(av), φ, ο, coord.h);
+    };
+
+    // Finds the next return of a body to its natal longitude, with the houses at that moment.
+    // Receives:
+    //  natalJD: the Julian day of birth
+    //  jdStart: a Julian day to search from
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  system: the house system, from houseSystems
+    //  mode: tropical or precession-corrected, from returnModes
+    // Returns:
+    //  an array: the Julian day of the return, the longitude of the body, then the twelve cusps, in the zodiac set by setZodiac;
+    //  an empty array if there is no return within 250 years, or on any other error
+    // Notes:
+    //  Longitudes are topocentric: the natal longitude is found for the same place as the return.
+    findReturn = (natalJD: number, jdStart: number, planet: number, coord: Geo, system: number, mode: number): Array<number> => {
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindReturn(natalJD, jdStart, φ, ο, coord.h, planet, system, mode) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetReturnContainer();
+        const end = begin + (sizeOfFloat64 * 14);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Finds the topocentric ecliptic position of a planet.
+    // Receives:
+    //  jd: a Julian day
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array, where longitude is provided first, in degrees in the zodiac set by setZodiac, then latitude in degrees,
+    //  then distance in AU; an empty array for an unknown planet or Pluto outside 1885 to 2099
+    findTopocentricPosition = (jd: number, planet: number, coord: Geo): Array<number> => {
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindTopocentricPosition(jd, φ, ο, coord.h, planet) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetTopocentricContainer();
+        const end = begin + (sizeOfFloat64 * 3);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Finds the topocentric equatorial and horizontal coordinates of a planet, in degrees.
+    // Receives:
+    //  jd: a Julian day
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array: right ascension, declination, azimuth from north, true altitude, then apparent altitude with refraction;
+    //  an empty array for an unknown planet or Pluto outside 1885 to 2099
+    findHorizontalPosition = (jd: number, planet: number, coord: Geo): Array<number> => {
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindHorizontalPosition(jd, φ, ο, coord.h, planet) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetHorizontalContainer();
+        const end = begin + (sizeOfFloat64 * 5);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Finds the geocentric daily motion of a planet.
+    // Receives:
+    //  jd: a Julian day
+    //  planet: a number representing the planet
+    // Returns:
+    //  an array: longitude and latitude speeds in degrees per day, distance speed in AU per day, then 1 if retrograde or 0 if direct;
+    //  an empty array for an unknown planet or Pluto outside 1885 to 2099
+    findSpeed = (jd: number, planet: number): Array<number> => {
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindSpeed(jd, planet) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetSpeedContainer();
+        const end = begin + (sizeOfFloat64 * 4);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Finds the rising, transit and setting of a planet within a day.
+    // Receives:
+    //  jd: the start of the day, as a Julian day; local midnight gives the events of the local date
+    //  planet: a number representing the planet
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array: rising, transit and setting as Julian days, 0 for an event that does not happen within the day,
+    //  then the status: 0 normal, 1 circumpolar, 2 never rises; an empty array for an unknown planet or Pluto outside 1885 to 2099
+    findRiseTransitSet = (jd: number, planet: number, coord: Geo): Array<number> => {
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindRiseTransitSet(jd, φ, ο, coord.h, planet) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetRiseSetContainer();
+        const end = begin + (sizeOfFloat64 * 4);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Finds dawn and dusk for an altitude of the Sun on a given day.
+    // Receives:
+    //  jd: a Julian day
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    //  altitude: the altitude of the Sun's center, in degrees: -6 civil, -12 nautical, -18 astronomical
+    // Returns:
+    //  an array, where dawn is provided first, then dusk, then the status: 0 normal, 1 twilight never ends, 2 no such twilight
+    findTwilight = (jd: number, coord: Geo, altitude: number): Array<number> => {
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        this.wasmFindTwilight(jd, φ, ο, this.wasmFindAngleFromDeg(altitude));
+        const begin = this.wasmGetTwilightPtr();
+        const end = begin + (sizeOfFloat64 * 3);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Finds the planetary hours of a local date.
+    // Receives:
+    //  jd: a Julian day within the local date, taken in local mean time
+    //  coord: a Geo interface representing the observer's geographic coordinates
+    // Returns:
+    //  an array: the ruler of the day, then the start, end and ruler of each of the 24 hours, as Julian days and planet numbers;
+    //  an empty array on a day the Sun does not rise or set, as in polar summer and winter
+    findPlanetaryHours = (jd: number, coord: Geo): Array<number> => {
+        const φ = this.wasmFindAngleFromDeg(coord.φ);
+        const ο = this.wasmFindAngleFromDeg(coord.ο);
+        // WASM returns the Go bool as 0 or 1.
+        if (this.wasmFindPlanetaryHours(jd, φ, ο) === 0) {
+            return [];
+        }
+        const begin = this.wasmGetPlanetaryHoursContainer();
+        const end = begin + (sizeOfFloat64 * 73);
+        const memView = new Float64Array(this.memory.buffer.slice(begin, end));
+        return Array.from(memView);
+    };
+
+    // Converts a tropical longitude to the zodiac set by setZodiac.
+    // Receives:
+    //  λ: the tropical longitude, in degrees
+    //  jd: a Julian day
+    // Returns:
+    //  the longitude in the zodiac, in degrees
+    toZodiac(λ: number, jd: number): number {
+        return this.wasmToZodiac(this.wasmFindAngleFromDeg(λ), jd);
+    }
+
     fractionalDay(time: Moment): number {
         return time.date() + (this.durationSinceMidnight(time) / 24.0);
     }
@@ -301,8 +749,47 @@ export class EphLoader implements Resolve<AstroFns> {
     private wasmFindSunRiseSet: (jd: number, φ: number, ο: number) => void = () => 0;
     private wasmGetObliquityLSTContainer: () => number = () => 0;
     private wasmFindObliquityLST: (jd: number, ο: number) => void = () => 0;
-    private wasmGetHouseContainer: () => number = () => 0;
-    private wasmFindHouses: (lst: number, ε: number, φ: number) => void = () => 0;
+    private wasmGetHouseContainer: (system: number) => number = () => 0;
+    private wasmFindHouses: (lst: number, ε: number, φ: number, system: number) => number = () => 0;
     private wasmGetTimeContainer: () => number = () => 0;
     private wasmJdToCalendar: (jd: number) => void = () => 0;
+    private wasmSetCalendarReform: (jd: number) => void = () => 0;
+    private wasmGetZoneNameContainer: () => number = () => 0;
+    private wasmGetZonedJDContainer: () => number = () => 0;
+    private wasmFindZonedJD: (y: number, m: number, d: number, h: number, min: number, s: number, nameLength: number,
+        policy: number) => number = () => 0;
+    private wasmSetZodiac: (system: number) => void = () => 0;
+    private wasmSetUserAyanamsa: (epoch: number, value: number) => void = () => 0;
+    private wasmFindAyanamsa: (jd: number, system: number) => number = () => 0;
+    private wasmToZodiac: (λ: number, jd: number) => number = () => 0;
+    private wasmGetLotsContainer: () => number = () => 0;
+    private wasmFindLots: (jd: number, φ: number, ο: number, h: number) => number = () => 0;
+    private wasmGetFormulaContainer: () => number = () => 0;
+    private wasmGetCustomLotsContainer: () => number = () => 0;
+    private wasmFindCustomLots: (jd: number, φ: number, ο: number, h: number, count: number) => number = () => 0;
+    private wasmGetStarNameContainer: () => number = () => 0;
+    private wasmGetStarPositionContainer: () => number = () => 0;
+    private wasmGetStarCount: () => number = () => 0;
+    private wasmFindStarIndex: (nameLength: number) => number = () => 0;
+    private wasmFindStarName: (index: number) => number = () => 0;
+    private wasmFindStarPosition: (index: number, jd: number) => number = () => 0;
+    private wasmFindStarHeliacal: (event: number, jd: number, index: number, av: number, φ: number, ο: number,
+        h: number) => number = () => 0;
+    private wasmFindPlanetHeliacal: (event: number, jd: number, planet: number, av: number, φ: number, ο: number,
+        h: number) => number = () => 0;
+    private wasmGetReturnContainer: () => number = () => 0;
+    private wasmFindReturn: (natalJD: number, jdStart: number, φ: number, ο: number, h: number, planet: number, system: number,
+        mode: number) => number = () => 0;
+    private wasmGetTopocentricContainer: () => number = () => 0;
+    private wasmFindTopocentricPosition: (jd: number, φ: number, ο: number, h: number, planet: number) => number = () => 0;
+    private wasmGetHorizontalContainer: () => number = () => 0;
+    private wasmFindHorizontalPosition: (jd: number, φ: number, ο: number, h: number, planet: number) => number = () => 0;
+    private wasmGetSpeedContainer: () => number = () => 0;
+    private wasmFindSpeed: (jd: number, planet: number) => number = () => 0;
+    private wasmGetRiseSetContainer: () => number = () => 0;
+    private wasmFindRiseTransitSet: (jd: number, φ: number, ο: number, h: number, planet: number) => number = () => 0;
+    private wasmGetTwilightPtr: () => number = () => 0;
+    private wasmFindTwilight: (jde: number, φ: number, ο: number, altitude: number) => void = () => 0;
+    private wasmGetPlanetaryHoursContainer: () => number = () => 0;
+    private wasmFindPlanetaryHours: (jde: number, φ: number, ο: number) => number = () => 0;
 }